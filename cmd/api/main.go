@@ -25,6 +25,9 @@ func main() {
 	var port = flag.Int("port", 8888, "Port for HTTP server")
 	var dir = flag.String("dir", "/srv/packages", "Root directory for packages/config")
 	var indexWorkers = flag.Int("index-workers", 10, "Number of concurrent workers for APKINDEX generation")
+	var mirrorSync = flag.Bool("mirror-sync", true, "Sync mirrored repos from upstream in the background")
+	var blobStore = flag.Bool("blob-store", false, "Store packages once by sha256 and refer to them from each repo they're in")
+	var maxUploadMB = flag.Int64("max-upload-mb", 1024, "Biggest package upload to accept, in MiB")
 
 	flag.Parse()
 
@@ -48,6 +51,15 @@ func main() {
 	// Create an instance of our handler which satisfies the generated interface
 	papi := repoApi.NewPkgRepo(*dir)
 	repoApi.SetIndexWorkers(*indexWorkers)
+	repoApi.SetMaxUploadSize(*maxUploadMB << 20)
+	go repoApi.RunUploadCleanup(context.Background(), repoApi.PackageBaseDirectory)
+	if *mirrorSync {
+		go repoApi.RunMirrorSync(context.Background(), repoApi.PackageBaseDirectory)
+	}
+	if *blobStore {
+		repoApi.SetBlobStore(true)
+		go repoApi.RunBlobGC(context.Background(), repoApi.PackageBaseDirectory)
+	}
 
 	// This is how you set up a basic Echo router
 	e := echo.New()
@@ -90,6 +102,9 @@ func main() {
 	// secure middleware
 	e.Use(middleware.Secure())
 
+	// record everything that changes something, including what the validator turns away
+	e.Use(repoApi.AuditLog(swagger))
+
 	// Use our validation middleware to check all requests against the
 	// OpenAPI schema.
 	validatorOptions := &echomiddleware.Options{}
@@ -100,6 +115,7 @@ func main() {
 		// they probably forgot to set the auth header or the env var for the token
 		if input.RequestValidationInput.Request.Header.Get("Authorization") == "" ||
 			input.RequestValidationInput.Request.Header["Authorization"][0] == "Bearer" {
+			repoApi.ObserveAuthFailure(orgName, "missing_token")
 			return errors.New("no auth token")
 		}
 		token := strings.Split(input.RequestValidationInput.Request.Header["Authorization"][0], " ")[1]
@@ -108,13 +124,22 @@ func main() {
 				return nil
 			}
 		}
+		repoApi.ObserveAuthFailure(orgName, "invalid_token")
 		return errors.New("invalid auth")
 	}
 	validatorOptions.Skipper = func(ctx echo.Context) bool {
 		// we want the prometheus middleware to handle this, not the normal openapi route
-		return ctx.Path() == "/metrics"
+		return ctx.Path() == "/metrics" || repoApi.IsPackageUpload(ctx)
 	}
 	e.Use(echomiddleware.OapiRequestValidatorWithOptions(swagger, validatorOptions))
+	// package uploads are streamed straight to storage, the validator would read the whole body
+	// into memory first, so they're checked (and authenticated) without it
+	uploadValidatorOptions := *validatorOptions
+	uploadValidatorOptions.Options.ExcludeRequestBody = true
+	uploadValidatorOptions.Skipper = func(ctx echo.Context) bool {
+		return !repoApi.IsPackageUpload(ctx)
+	}
+	e.Use(echomiddleware.OapiRequestValidatorWithOptions(swagger, &uploadValidatorOptions))
 
 	// We now register our API above as the handler for the interface
 	repoApi.RegisterHandlers(e, papi)
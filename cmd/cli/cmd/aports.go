@@ -0,0 +1,279 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"gitlab.alpinelinux.org/alpine/go/apkbuild"
+)
+
+// apkbuildInfo - the packages an APKBUILD builds
+type apkbuildInfo struct {
+	path string
+	// origin - the pkgname, which every subpackage lists as its origin
+	origin string
+	// version - pkgver-rpkgrel, the version of every package it builds
+	version string
+	// arch - the arch= entries, e.g. all !s390x or noarch
+	arch []string
+	// packages - pkgname followed by the subpackages
+	packages []apkbuildPackage
+}
+
+// apkbuildPackage - one package built by an APKBUILD
+type apkbuildPackage struct {
+	name string
+	// noarch - whether the .apk itself is noarch (it still ends up in every arch the APKBUILD is
+	// built for, the same way the builders publish them)
+	noarch bool
+}
+
+// buildsFor - whether the APKBUILD gets built for arch, following abuild's rules: an explicit
+// !arch always wins, otherwise all, noarch or the arch itself have to be listed
+func (a apkbuildInfo) buildsFor(arch string) bool {
+	built := false
+	for _, entry := range a.arch {
+		switch entry {
+		case "!" + arch:
+			return false
+		case "all", "noarch", arch:
+			built = true
+		}
+	}
+	return built
+}
+
+// filename - the .apk filename of one of the packages
+func (a apkbuildInfo) filename(name string) string {
+	return fmt.Sprintf("%s-%s.apk", name, a.version)
+}
+
+// noarchSubpackageSuffixes - the subpackages abuild's default split functions make noarch
+var noarchSubpackageSuffixes = []string{"-doc", "-openrc", "-bash-completion", "-zsh-completion", "-fish-completion", "-lang"}
+
+func subpackageIsNoarch(sp apkbuild.Subpackage) bool {
+	if sp.Arch != "" {
+		return sp.Arch == "noarch"
+	}
+	if sp.SplitFunc != "" {
+		// a custom split function sets whatever arch it likes, assume it's the same as the main package
+		return false
+	}
+	for _, suffix := range noarchSubpackageSuffixes {
+		if strings.HasSuffix(sp.Subpkgname, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseApkBuild - read an APKBUILD, the package name defaults to the name of the dir it's in the
+// same way abuild does it
+func parseApkBuild(apkBuildPath string) (apkbuildInfo, error) {
+	fp, err := os.Open(apkBuildPath)
+	if err != nil {
+		return apkbuildInfo{}, err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	abf := apkbuild.ApkbuildFile{
+		PackageName: filepath.Base(filepath.Dir(apkBuildPath)),
+		Content:     fp,
+	}
+	parsed, err := apkbuild.Parse(abf, nil)
+	if err != nil {
+		return apkbuildInfo{}, fmt.Errorf("failed to parse %s: %w", apkBuildPath, err)
+	}
+	switch {
+	case parsed.Pkgname == "":
+		return apkbuildInfo{}, fmt.Errorf("%s has no pkgname", apkBuildPath)
+	case parsed.Pkgver == "":
+		return apkbuildInfo{}, fmt.Errorf("%s has no pkgver", apkBuildPath)
+	case parsed.Pkgrel == "":
+		return apkbuildInfo{}, fmt.Errorf("%s has no pkgrel", apkBuildPath)
+	case len(parsed.Arch) == 0:
+		return apkbuildInfo{}, fmt.Errorf("%s has no arch", apkBuildPath)
+	}
+
+	noarch := slices.Contains(parsed.Arch, "noarch")
+	info := apkbuildInfo{
+		path:     apkBuildPath,
+		origin:   parsed.Pkgname,
+		version:  fmt.Sprintf("%s-r%s", parsed.Pkgver, parsed.Pkgrel),
+		arch:     parsed.Arch,
+		packages: []apkbuildPackage{{name: parsed.Pkgname, noarch: noarch}},
+	}
+	for _, sp := range parsed.Subpackages {
+		if sp.Subpkgname == "" {
+			return apkbuildInfo{}, fmt.Errorf("%s has an empty subpackage name", apkBuildPath)
+		}
+		info.packages = append(info.packages, apkbuildPackage{name: sp.Subpkgname, noarch: noarch || subpackageIsNoarch(sp)})
+	}
+	return info, nil
+}
+
+// findApkBuilds - the APKBUILDs in an aports style checkout (<dir>/<repo>/<pkgname>/APKBUILD)
+// keyed by repo, an empty repo returns every repo in the checkout
+// APKBUILDs that fail to parse are returned as errors alongside everything that did parse
+func findApkBuilds(dir, repo string) (map[string][]apkbuildInfo, []error) {
+	result := map[string][]apkbuildInfo{}
+	var errs []error
+
+	pattern := filepath.Join(dir, "*", "*", "APKBUILD")
+	if repo != "" {
+		pattern = filepath.Join(dir, repo, "*", "APKBUILD")
+	}
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, []error{err}
+	}
+	for _, p := range paths {
+		info, err := parseApkBuild(p)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r := filepath.Base(filepath.Dir(filepath.Dir(p)))
+		result[r] = append(result[r], info)
+	}
+	return result, errs
+}
+
+// splitApkFilename - split name-1.2.3-r0.apk into name and 1.2.3-r0
+func splitApkFilename(filename string) (string, string, bool) {
+	base := strings.TrimSuffix(filename, ".apk")
+	rel := strings.LastIndex(base, "-")
+	if rel < 0 {
+		return "", "", false
+	}
+	ver := strings.LastIndex(base[:rel], "-")
+	if ver < 0 {
+		return "", "", false
+	}
+	return base[:ver], base[ver+1:], true
+}
+
+// missingPackage - a package an APKBUILD builds that isn't on the server
+type missingPackage struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Origin   string `json:"origin"`
+	APKBUILD string `json:"apkbuild"`
+}
+
+// outdatedPackage - a package that's on the server, but not at the version the APKBUILD builds
+type outdatedPackage struct {
+	Name          string   `json:"name"`
+	Version       string   `json:"version"`
+	ServerVersion []string `json:"serverVersions"`
+	Origin        string   `json:"origin"`
+	APKBUILD      string   `json:"apkbuild"`
+}
+
+// extraPackage - a package on the server that no APKBUILD builds
+type extraPackage struct {
+	Name     string   `json:"name"`
+	Versions []string `json:"versions"`
+}
+
+// presentPackage - a package that's on the server at the version the APKBUILD builds
+type presentPackage struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename"`
+	Noarch   bool   `json:"noarch"`
+	Origin   string `json:"origin"`
+}
+
+// repoComparison - how a repo/arch on the server compares to the aports checkout
+type repoComparison struct {
+	Repo     string            `json:"repo"`
+	Arch     string            `json:"arch"`
+	Present  []presentPackage  `json:"present"`
+	Missing  []missingPackage  `json:"missing"`
+	Outdated []outdatedPackage `json:"outdated"`
+	Extra    []extraPackage    `json:"extra"`
+	// Skipped - APKBUILDs that aren't built for this arch
+	Skipped []string `json:"skipped"`
+}
+
+// compareRepo - compare the packages the APKBUILDs of a repo build with a server package listing
+func compareRepo(repo, arch string, apkbuilds []apkbuildInfo, server []repoApi.Package) repoComparison {
+	result := repoComparison{
+		Repo:     repo,
+		Arch:     arch,
+		Present:  []presentPackage{},
+		Missing:  []missingPackage{},
+		Outdated: []outdatedPackage{},
+		Extra:    []extraPackage{},
+		Skipped:  []string{},
+	}
+
+	onServer := map[string]bool{}
+	serverVersions := map[string][]string{}
+	for _, p := range server {
+		onServer[p.Name] = true
+		name, version, ok := splitApkFilename(p.Name)
+		if ok {
+			serverVersions[name] = append(serverVersions[name], version)
+		}
+	}
+
+	expected := map[string]bool{}
+	for _, a := range apkbuilds {
+		if !a.buildsFor(arch) {
+			result.Skipped = append(result.Skipped, a.origin)
+			continue
+		}
+		for _, p := range a.packages {
+			expected[p.name] = true
+			switch {
+			case onServer[a.filename(p.name)]:
+				result.Present = append(result.Present, presentPackage{Name: p.name, Filename: a.filename(p.name), Noarch: p.noarch, Origin: a.origin})
+			case len(serverVersions[p.name]) > 0:
+				result.Outdated = append(result.Outdated, outdatedPackage{Name: p.name, Version: a.version, ServerVersion: serverVersions[p.name], Origin: a.origin, APKBUILD: a.path})
+			default:
+				result.Missing = append(result.Missing, missingPackage{Name: p.name, Version: a.version, Origin: a.origin, APKBUILD: a.path})
+			}
+		}
+	}
+	for name, versions := range serverVersions {
+		if !expected[name] {
+			result.Extra = append(result.Extra, extraPackage{Name: name, Versions: versions})
+		}
+	}
+
+	sort.Slice(result.Present, func(i, j int) bool { return result.Present[i].Name < result.Present[j].Name })
+	sort.Slice(result.Missing, func(i, j int) bool { return result.Missing[i].Name < result.Missing[j].Name })
+	sort.Slice(result.Outdated, func(i, j int) bool { return result.Outdated[i].Name < result.Outdated[j].Name })
+	sort.Slice(result.Extra, func(i, j int) bool { return result.Extra[i].Name < result.Extra[j].Name })
+	sort.Strings(result.Skipped)
+	return result
+}
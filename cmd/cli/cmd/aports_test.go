@@ -0,0 +1,279 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/apkbuild"
+)
+
+// writeApkBuild - write an APKBUILD to <dir>/<repo>/<pkgname>/APKBUILD the way aports lays them out
+func writeApkBuild(t *testing.T, dir, repo, pkgname, content string) string {
+	t.Helper()
+	p := filepath.Join(dir, repo, pkgname, "APKBUILD")
+	err := os.MkdirAll(filepath.Dir(p), 0755)
+	if err != nil {
+		t.Fatal("failed to create APKBUILD dir", err)
+	}
+	err = os.WriteFile(p, []byte(content), 0644)
+	if err != nil {
+		t.Fatal("failed to write APKBUILD", err)
+	}
+	return p
+}
+
+func TestBuildsFor(t *testing.T) {
+	tests := []struct {
+		arch  []string
+		build string
+		want  bool
+	}{
+		{[]string{"all", "!x86"}, "x86_64", true},
+		{[]string{"all", "!x86"}, "x86", false},
+		// !arch wins wherever it is
+		{[]string{"!x86", "all"}, "x86", false},
+		{[]string{"x86", "!x86"}, "x86", false},
+		{[]string{"noarch"}, "x86_64", true},
+		{[]string{"noarch"}, "s390x", true},
+		{[]string{"x86_64", "aarch64"}, "aarch64", true},
+		{[]string{"x86_64", "aarch64"}, "armv7", false},
+		{[]string{"!x86_64"}, "aarch64", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.build+" in "+strings.Join(tc.arch, " "), func(t *testing.T) {
+			assert.Equal(t, tc.want, apkbuildInfo{arch: tc.arch}.buildsFor(tc.build))
+		})
+	}
+}
+
+func TestSubpackageIsNoarch(t *testing.T) {
+	tests := []struct {
+		sp   apkbuild.Subpackage
+		want bool
+	}{
+		{apkbuild.Subpackage{Subpkgname: "foo-doc"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-openrc"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-bash-completion"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-lang"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-dev"}, false},
+		{apkbuild.Subpackage{Subpkgname: "foo-libs"}, false},
+		// a split function decides for itself
+		{apkbuild.Subpackage{Subpkgname: "foo-doc", SplitFunc: "_doc"}, false},
+		// and an explicit arch beats everything
+		{apkbuild.Subpackage{Subpkgname: "foo-doc", SplitFunc: "_doc", Arch: "noarch"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-tools", SplitFunc: "_tools", Arch: "noarch"}, true},
+		{apkbuild.Subpackage{Subpkgname: "foo-doc", SplitFunc: "_doc", Arch: "x86_64"}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.sp.Subpkgname+":"+tc.sp.SplitFunc+":"+tc.sp.Arch, func(t *testing.T) {
+			assert.Equal(t, tc.want, subpackageIsNoarch(tc.sp))
+		})
+	}
+}
+
+func TestParseApkBuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		version  string
+		arch     []string
+		packages []apkbuildPackage
+		err      string
+	}{
+		{
+			name:    "foo",
+			content: "pkgname=foo\npkgver=1.2.3\npkgrel=4\narch=\"all !s390x\"\nsubpackages=\"foo-doc foo-dev foo-openrc foo-tools:_tools:noarch foo-extra:_extra\"\n",
+			version: "1.2.3-r4",
+			arch:    []string{"all", "!s390x"},
+			packages: []apkbuildPackage{
+				{name: "foo"},
+				{name: "foo-doc", noarch: true},
+				{name: "foo-dev"},
+				{name: "foo-openrc", noarch: true},
+				{name: "foo-tools", noarch: true},
+				{name: "foo-extra"},
+			},
+		},
+		{
+			// everything a noarch APKBUILD builds is noarch
+			name:     "py3-bar",
+			content:  "pkgname=py3-bar\npkgver=0.1\npkgrel=0\narch=\"noarch\"\nsubpackages=\"py3-bar-pyc\"\n",
+			version:  "0.1-r0",
+			arch:     []string{"noarch"},
+			packages: []apkbuildPackage{{name: "py3-bar", noarch: true}, {name: "py3-bar-pyc", noarch: true}},
+		},
+		{name: "no-pkgrel", content: "pkgname=no-pkgrel\npkgver=1.0\narch=\"all\"\n", err: "pkgrel"},
+		{name: "no-arch", content: "pkgname=no-arch\npkgver=1.0\npkgrel=0\n", err: "arch"},
+		{name: "no-subpkgname", content: "pkgname=no-subpkgname\npkgver=1.0\npkgrel=0\narch=\"all\"\nsubpackages=\":_split\"\n", err: "subpackage name"},
+	}
+
+	dir := t.TempDir()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := writeApkBuild(t, dir, "main", tc.name, tc.content)
+			info, err := parseApkBuild(p)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, apkbuildInfo{path: p, origin: tc.name, version: tc.version, arch: tc.arch, packages: tc.packages}, info)
+		})
+	}
+}
+
+func TestCompareRepo(t *testing.T) {
+	dir := t.TempDir()
+	var apkbuilds []apkbuildInfo
+	for name, content := range map[string]string{
+		"foo": "pkgname=foo\npkgver=1.2\npkgrel=0\narch=\"all\"\nsubpackages=\"foo-doc foo-dev\"\n",
+		"bar": "pkgname=bar\npkgver=2.0\npkgrel=1\narch=\"x86_64 aarch64\"\n",
+		"baz": "pkgname=baz\npkgver=1.0\npkgrel=0\narch=\"all !x86_64\"\n",
+		"qux": "pkgname=qux\npkgver=3\npkgrel=0\narch=\"noarch\"\n",
+	} {
+		info, err := parseApkBuild(writeApkBuild(t, dir, "main", name, content))
+		assert.NoError(t, err)
+		apkbuilds = append(apkbuilds, info)
+	}
+	server := []repoApi.Package{
+		{Name: "APKINDEX.tar.gz"},
+		{Name: "foo-1.2-r0.apk"},
+		{Name: "foo-doc-1.1-r0.apk"},
+		{Name: "qux-3-r0.apk"},
+		{Name: "gone-1.0-r0.apk"},
+	}
+
+	result := compareRepo("main", "x86_64", apkbuilds, server)
+	assert.Equal(t, []presentPackage{
+		{Name: "foo", Filename: "foo-1.2-r0.apk", Origin: "foo"},
+		{Name: "qux", Filename: "qux-3-r0.apk", Noarch: true, Origin: "qux"},
+	}, result.Present)
+	assert.Equal(t, []missingPackage{
+		{Name: "bar", Version: "2.0-r1", Origin: "bar", APKBUILD: filepath.Join(dir, "main", "bar", "APKBUILD")},
+		{Name: "foo-dev", Version: "1.2-r0", Origin: "foo", APKBUILD: filepath.Join(dir, "main", "foo", "APKBUILD")},
+	}, result.Missing)
+	assert.Equal(t, []outdatedPackage{
+		{Name: "foo-doc", Version: "1.2-r0", ServerVersion: []string{"1.1-r0"}, Origin: "foo", APKBUILD: filepath.Join(dir, "main", "foo", "APKBUILD")},
+	}, result.Outdated)
+	assert.Equal(t, []extraPackage{{Name: "gone", Versions: []string{"1.0-r0"}}}, result.Extra)
+	assert.Equal(t, []string{"baz"}, result.Skipped)
+
+	// on an arch only some of them are built for
+	result = compareRepo("main", "armv7", apkbuilds, nil)
+	assert.Empty(t, result.Present)
+	assert.Len(t, result.Missing, 5)
+	assert.Empty(t, result.Outdated)
+	assert.Empty(t, result.Extra)
+	assert.Equal(t, []string{"bar"}, result.Skipped)
+}
+
+func TestSplitApkFilename(t *testing.T) {
+	tests := []struct {
+		filename, name, version string
+		ok                      bool
+	}{
+		{"foo-1.2-r0.apk", "foo", "1.2-r0", true},
+		{"py3-foo-bar-1.2-r0.apk", "py3-foo-bar", "1.2-r0", true},
+		{"foo-bar-doc-1.0_rc1-r10.apk", "foo-bar-doc", "1.0_rc1-r10", true},
+		{"foo-1.2.apk", "", "", false},
+		{"foo.apk", "", "", false},
+		{"APKINDEX.tar.gz", "", "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.filename, func(t *testing.T) {
+			name, version, ok := splitApkFilename(tc.filename)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.name, name)
+			assert.Equal(t, tc.version, version)
+		})
+	}
+}
+
+func TestFindApkBuilds(t *testing.T) {
+	dir := t.TempDir()
+	foo := writeApkBuild(t, dir, "main", "foo", "pkgname=foo\npkgver=1.0\npkgrel=0\narch=\"all\"\n")
+	bar := writeApkBuild(t, dir, "community", "bar", "pkgname=bar\npkgver=2.0\npkgrel=0\narch=\"all\"\n")
+	broken := writeApkBuild(t, dir, "main", "broken", "pkgname=broken\npkgver=1.0\narch=\"all\"\n")
+	// not an aports layout
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "APKBUILD"), []byte("pkgname=stray\n"), 0644))
+
+	found, errs := findApkBuilds(dir, "")
+	if assert.Len(t, errs, 1) {
+		assert.ErrorContains(t, errs[0], broken)
+	}
+	assert.Len(t, found, 2)
+	if assert.Len(t, found["main"], 1) {
+		assert.Equal(t, foo, found["main"][0].path)
+	}
+	if assert.Len(t, found["community"], 1) {
+		assert.Equal(t, bar, found["community"][0].path)
+	}
+
+	found, errs = findApkBuilds(dir, "community")
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"community"}, slices.Collect(maps.Keys(found)))
+
+	found, errs = findApkBuilds(dir, "testing")
+	assert.Empty(t, errs)
+	assert.Empty(t, found)
+}
+
+func TestCompareRepoDashedNames(t *testing.T) {
+	dir := t.TempDir()
+	info, err := parseApkBuild(writeApkBuild(t, dir, "main", "py3-foo-bar", "pkgname=py3-foo-bar\npkgver=1.2\npkgrel=0\narch=\"noarch\"\n"))
+	assert.NoError(t, err)
+	server := []repoApi.Package{
+		{Name: "py3-foo-bar-1.1-r0.apk"},
+		{Name: "py3-foo-bar-1.1-r1.apk"},
+		// a different package whose name is the start of this one's
+		{Name: "py3-foo-1.2-r0.apk"},
+	}
+
+	// an older build of something that's still built is outdated, not extra
+	result := compareRepo("main", "x86_64", []apkbuildInfo{info}, server)
+	assert.Empty(t, result.Present)
+	assert.Empty(t, result.Missing)
+	if assert.Len(t, result.Outdated, 1) {
+		assert.Equal(t, "py3-foo-bar", result.Outdated[0].Name)
+		assert.Equal(t, "1.2-r0", result.Outdated[0].Version)
+		assert.ElementsMatch(t, []string{"1.1-r0", "1.1-r1"}, result.Outdated[0].ServerVersion)
+	}
+	assert.Equal(t, []extraPackage{{Name: "py3-foo", Versions: []string{"1.2-r0"}}}, result.Extra)
+
+	// and once the new version's there, it's present and the old ones aren't extra either
+	server = append(server, repoApi.Package{Name: "py3-foo-bar-1.2-r0.apk"})
+	result = compareRepo("main", "x86_64", []apkbuildInfo{info}, server)
+	assert.Equal(t, []presentPackage{{Name: "py3-foo-bar", Filename: "py3-foo-bar-1.2-r0.apk", Noarch: true, Origin: "py3-foo-bar"}}, result.Present)
+	assert.Empty(t, result.Outdated)
+	assert.Equal(t, []extraPackage{{Name: "py3-foo", Versions: []string{"1.2-r0"}}}, result.Extra)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show the org's audit log",
+	Long: `Every request that changes something in the org (uploads, index builds,
+snapshots, keys, ...) is recorded with the token that sent it, where it went,
+the packages it wrote and whether it worked.
+
+--since and --until take an RFC 3339 time or a duration that long ago (e.g. 24h).
+--export writes the entries to stdout as JSON lines, one per entry, as they're
+read, for shipping the log somewhere else.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var params repoApi.ListAuditEntriesParams
+		for flag, dst := range map[string]**time.Time{"since": &params.Since, "until": &params.Until} {
+			value, _ := cmd.Flags().GetString(flag)
+			if value == "" {
+				continue
+			}
+			t, err := parseAuditTime(value)
+			if err != nil {
+				return withExitCode(exitUsage, fmt.Errorf("invalid --%s: %w", flag, err))
+			}
+			*dst = &t
+		}
+		operation, _ := cmd.Flags().GetString("operation")
+		if operation != "" {
+			params.Operation = &operation
+		}
+		export, _ := cmd.Flags().GetBool("export")
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		if export {
+			format := repoApi.Jsonl
+			params.Format = &format
+			resp, err := client.ListAuditEntries(context.Background(), cfg.Org, &params)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				return responseError("export audit log", resp.StatusCode, body)
+			}
+			_, err = io.Copy(os.Stdout, resp.Body)
+			return err
+		}
+
+		resp, err := client.ListAuditEntriesWithResponse(context.Background(), cfg.Org, &params)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("list audit log", resp.StatusCode(), resp.Body)
+		}
+		entries := *resp.JSON200
+		return render(entries, func(w io.Writer) { printAuditEntries(w, entries) })
+	},
+}
+
+// parseAuditTime - an RFC 3339 time, or a duration before now
+func parseAuditTime(value string) (time.Time, error) {
+	d, err := time.ParseDuration(value)
+	if err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func printAuditEntries(w io.Writer, entries []repoApi.AuditEntry) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tTOKEN\tOPERATION\tPATH\tSTATUS\tCLIENT\tFILES")
+	for _, e := range entries {
+		token := "-"
+		if e.TokenName != nil {
+			token = *e.TokenName
+		} else if e.TokenId != nil {
+			token = *e.TokenId
+		}
+		files := 0
+		if e.Files != nil {
+			files = len(*e.Files)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s %s\t%d\t%s\t%d\n", e.Time.Local().Format("2006-01-02 15:04:05"), token, e.Operation, e.Method, e.Path, e.Status, e.ClientIp, files)
+	}
+	_ = tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().String("since", "", "only entries at or after this time")
+	auditCmd.Flags().String("until", "", "only entries before this time")
+	auditCmd.Flags().String("operation", "", "only entries for this operation (e.g. UploadRepoFile)")
+	auditCmd.Flags().Bool("export", false, "write the entries to stdout as JSON lines")
+}
@@ -1,5 +1,5 @@
 /*
-Copyright © 2024 Iggy Jackson <iggy@iggy.ninja>
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
 
 Permission is hereby granted, free of charge, to any person obtaining a copy
 of this software and associated documentation files (the "Software"), to deal
@@ -22,36 +22,134 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"sort"
+	"strings"
 
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
 // allCmd represents the all command
 var allCmd = &cobra.Command{
-	Use:   "all",
-	Short: "A brief description of your command",
-	Long: `A longer description that spans multiple lines and likely contains examples
-and usage of using your command. For example:
-
-Cobra is a CLI library for Go that empowers applications.
-This application is a tool to generate the needed files
-to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("check all called")
+	Use:   "all [aports dir]",
+	Short: "Compare an aports checkout with the server",
+	Long: `Walk an aports style checkout (<dir>/<repo>/<pkgname>/APKBUILD, the current
+dir by default) and compare the packages each APKBUILD builds, including
+subpackages, with what's on the server.
+
+Each repo is compared for every arch the server has for it, or just --arch.
+--repo limits it to a single repo. Packages are reported as missing (not on
+the server at all), outdated (on the server, but not at the APKBUILD's
+version) or extra (on the server, but nothing builds them for that arch).
+Only the APKBUILDs whose arch= includes an arch are expected there, noarch
+packages are expected in every arch.
+
+Use --output json to drive rebuilds from CI. Exits 3 if anything is missing
+or outdated, or an APKBUILD couldn't be parsed.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		apkbuilds, errs := findApkBuilds(dir, explicitly("repo", cfg.Repo))
+		for _, err := range errs {
+			log.Error().Err(err).Msg("skipping APKBUILD")
+		}
+		if len(apkbuilds) == 0 {
+			return fmt.Errorf("no APKBUILDs found in %s", dir)
+		}
+
+		ctx := context.Background()
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		repos := make([]string, 0, len(apkbuilds))
+		for repo := range apkbuilds {
+			repos = append(repos, repo)
+		}
+		sort.Strings(repos)
+
+		results := []repoComparison{}
+		for _, repo := range repos {
+			arches, err := repoArches(ctx, client, repo)
+			if err != nil {
+				return err
+			}
+			for _, arch := range arches {
+				pkgs, err := listAllPackages(ctx, client, cfg.Org, cfg.Distro, cfg.Version, repo, arch)
+				if err != nil {
+					return fmt.Errorf("%s/%s: %w", repo, arch, err)
+				}
+				results = append(results, compareRepo(repo, arch, apkbuilds[repo], pkgs))
+			}
+		}
+
+		err = render(results, func(w io.Writer) { printComparisons(w, results, false) })
+		if err != nil {
+			return err
+		}
+
+		problems := len(errs)
+		for _, r := range results {
+			problems += len(r.Missing) + len(r.Outdated)
+		}
+		if problems > 0 {
+			return errProblemsFound
+		}
+		return nil
 	},
 }
 
-func init() {
-	checkCmd.AddCommand(allCmd)
-
-	// Here you will define your flags and configuration settings.
+// repoArches - the arches to check for a repo, either the one that was asked for or every arch
+// the server has, a repo the server doesn't have yet gets checked against the default arch
+func repoArches(ctx context.Context, client *repoApi.ClientWithResponses, repo string) ([]string, error) {
+	if arch := explicitly("arch", cfg.Arch); arch != "" {
+		return []string{arch}, nil
+	}
+	arches, err := listAllArches(ctx, client, cfg.Org, cfg.Distro, cfg.Version, repo)
+	if exitCode(err) == exitMissing || (err == nil && len(arches) == 0) {
+		return []string{cfg.Arch}, nil
+	}
+	return arches, err
+}
 
-	// Cobra supports Persistent Flags which will work for this command
-	// and all subcommands, e.g.:
-	// allCmd.PersistentFlags().String("foo", "", "A help for foo")
+// printComparisons - print the problems in each comparison, and with all, the packages that are
+// fine and the APKBUILDs that aren't built for the arch too
+func printComparisons(w io.Writer, results []repoComparison, all bool) {
+	for _, r := range results {
+		if all {
+			for _, p := range r.Present {
+				arch := ""
+				if p.Noarch {
+					arch = " (noarch)"
+				}
+				fmt.Fprintf(w, "%s/%s: present %s%s\n", r.Repo, r.Arch, p.Filename, arch)
+			}
+			for _, origin := range r.Skipped {
+				fmt.Fprintf(w, "%s/%s: %s is not built for %s\n", r.Repo, r.Arch, origin, r.Arch)
+			}
+		}
+		for _, m := range r.Missing {
+			fmt.Fprintf(w, "%s/%s: missing %s-%s (%s)\n", r.Repo, r.Arch, m.Name, m.Version, m.APKBUILD)
+		}
+		for _, o := range r.Outdated {
+			fmt.Fprintf(w, "%s/%s: outdated %s, server has %s, APKBUILD has %s\n", r.Repo, r.Arch, o.Name, strings.Join(o.ServerVersion, ", "), o.Version)
+		}
+		for _, e := range r.Extra {
+			fmt.Fprintf(w, "%s/%s: extra %s-%s\n", r.Repo, r.Arch, e.Name, strings.Join(e.Versions, ", "))
+		}
+		log.Info().Str("repo", r.Repo).Str("arch", r.Arch).Int("present", len(r.Present)).Int("missing", len(r.Missing)).Int("outdated", len(r.Outdated)).Int("extra", len(r.Extra)).Msg("checked repo")
+	}
+}
 
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// allCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+func init() {
+	checkCmd.AddCommand(allCmd)
 }
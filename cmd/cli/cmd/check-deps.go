@@ -0,0 +1,131 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// depsCmd represents the deps command
+var depsCmd = &cobra.Command{
+	Use:   "deps",
+	Short: "Check a repo for broken dependencies",
+	Long: `Ask the server to resolve the dependencies of every package in a repo
+(along with the repo's configured parent repos) and report unsatisfied
+depends, names with more than one provider and dependency cycles.
+
+Every arch in the repo is checked unless one is set with --arch (or the
+environment/profile), and --all-repos checks every repo in the distro
+version instead of just --repo. Exits 3 if any problems were found.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		repos := []string{cfg.Repo}
+		if all, _ := cmd.Flags().GetBool("all-repos"); all {
+			list, err := listAllRepos(ctx, client, cfg.Org, cfg.Distro, cfg.Version)
+			if err != nil {
+				return err
+			}
+			repos = nil
+			for _, r := range list {
+				repos = append(repos, r.Name)
+			}
+		}
+
+		results := []repoDependencies{}
+		problems := 0
+		for _, repo := range repos {
+			reports, err := checkRepoDeps(ctx, client, repo, explicitly("arch", cfg.Arch))
+			if err != nil {
+				return fmt.Errorf("%s: %w", repo, err)
+			}
+			for _, report := range reports {
+				problems += len(report.Unsatisfied) + len(report.Conflicts) + len(report.Cycles)
+				log.Info().Str("repo", repo).Str("arch", report.Arch).Int("packages", report.Packages).Msg("checked dependencies")
+			}
+			results = append(results, repoDependencies{Repo: repo, Reports: reports})
+		}
+
+		err = render(results, func(w io.Writer) { printDependencyReports(w, results) })
+		if err != nil {
+			return err
+		}
+		if problems > 0 {
+			return errProblemsFound
+		}
+		return nil
+	},
+}
+
+// repoDependencies - the dependency reports for each arch of a repo
+type repoDependencies struct {
+	Repo    string                     `json:"repo"`
+	Reports []repoApi.DependencyReport `json:"reports"`
+}
+
+// checkRepoDeps - ask the server for the dependency reports of a repo
+func checkRepoDeps(ctx context.Context, client *repoApi.ClientWithResponses, repo, arch string) ([]repoApi.DependencyReport, error) {
+	params := &repoApi.CheckRepoDependenciesParams{}
+	if arch != "" {
+		params.Arch = &arch
+	}
+	resp, err := client.CheckRepoDependenciesWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, repo, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError("check dependencies", resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+func printDependencyReports(w io.Writer, results []repoDependencies) {
+	for _, r := range results {
+		for _, report := range r.Reports {
+			for _, u := range report.Unsatisfied {
+				fmt.Fprintf(w, "%s/%s: %s-%s depends on %s, which nothing provides\n", r.Repo, report.Arch, u.Package, u.Version, u.Dependency)
+			}
+			for _, c := range report.Conflicts {
+				fmt.Fprintf(w, "%s/%s: %s is provided by %v\n", r.Repo, report.Arch, c.Name, c.Providers)
+			}
+			for _, c := range report.Cycles {
+				fmt.Fprintf(w, "%s/%s: dependency cycle between %v\n", r.Repo, report.Arch, c)
+			}
+		}
+	}
+}
+
+func init() {
+	checkCmd.AddCommand(depsCmd)
+
+	depsCmd.Flags().Bool("all-repos", false, "check every repo in the distro version")
+}
@@ -23,67 +23,62 @@ package cmd
 
 import (
 	"context"
-	"os"
+	"errors"
+	"io"
 
-	repoApi "github.com/atlascloud/packages/internal/openapi"
-	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
-	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
 // pkgCmd represents the pkg command
 var pkgCmd = &cobra.Command{
-	Use:   "pkg",
-	Short: "Check if a package exists on the server",
-	Long: `A longer description that spans multiple lines and likely contains examples
-and usage of using your command. For example:
-
-Cobra is a CLI library for Go that empowers applications.
-This application is a tool to generate the needed files
-to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		// fmt.Println("pkg called")
-		// log.Debug().Strs("args", args).Msg("")
-		apkb, err := cmd.Flags().GetString("apkbuild")
+	Use:   "pkg [APKBUILD]...",
+	Short: "Check if the packages an APKBUILD builds exist on the server",
+	Long: `Check whether the packages built by one or more APKBUILDs (given as args or
+with --apkbuild), including subpackages, are in --repo/--arch on the server
+at the APKBUILD's version. APKBUILDs whose arch= excludes --arch are
+reported and skipped. The package listing is only fetched once no matter
+how many APKBUILDs are checked.
+
+Exits 3 if anything is missing or outdated.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		paths, err := cmd.Flags().GetStringArray("apkbuild")
 		if err != nil {
-			log.Error().Err(err).Msg("failed to get apkbuild flag")
+			return err
 		}
-		// getApkNameFromApkBuild(apkb)
-		apkFilename, err := getApkNameFromApkBuild(apkb)
-		if err != nil {
-			log.Fatal().Err(err).Msg("failed to getApkNameFromAPKBUILD")
+		paths = append(paths, args...)
+		if len(paths) == 0 {
+			return withExitCode(exitUsage, errors.New("no APKBUILDs to check"))
 		}
-		pkgsToken := os.Getenv("PKGS_TOKEN")
-		if pkgsToken == "" {
-			log.Fatal().Msg("no token found in environ")
+
+		var apkbuilds []apkbuildInfo
+		for _, p := range paths {
+			info, err := parseApkBuild(p)
+			if err != nil {
+				return err
+			}
+			apkbuilds = append(apkbuilds, info)
 		}
 
-		bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken(pkgsToken)
+		client, err := newClient()
 		if err != nil {
-			log.Fatal().Err(err).Msg("failed to init security provider")
+			return err
 		}
-
-		client, err := repoApi.NewClientWithResponses("https://packages.atlascloud.xyz/api", repoApi.WithRequestEditorFn(bearerTokenProvider.Intercept))
+		pkgs, err := listAllPackages(context.Background(), client, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, cfg.Arch)
 		if err != nil {
-			log.Error().Err(err).Msg("failed to create client")
+			return err
 		}
 
-		resp, err := client.ListPackagesByRepoWithResponse(context.Background(), "atlascloud", "alpine", "edge", "main", "x86_64")
+		result := compareRepo(cfg.Repo, cfg.Arch, apkbuilds, pkgs)
+		// only the packages that were asked about matter here
+		result.Extra = []extraPackage{}
+		err = render(result, func(w io.Writer) { printComparisons(w, []repoComparison{result}, true) })
 		if err != nil {
-			log.Error().Err(err).Msg("failed to ping")
+			return err
 		}
-
-		// respBody, _ := io.ReadAll(resp.Body)
-		// log.Debug().Str("resp", string(resp.Body)).Msg("resp")
-
-		for _, o := range *resp.JSON200 {
-			// log.Debug().Interface("package", o.Name).Msg("")
-			if o.Name == apkFilename {
-				log.Info().Str("package file name", apkFilename).Msg("package already exists on server")
-				return
-			}
+		if len(result.Missing) > 0 || len(result.Outdated) > 0 {
+			return errProblemsFound
 		}
-		log.Info().Str("package file name", apkFilename).Msg("package does not exist on server")
+		return nil
 	},
 }
 
@@ -99,5 +94,5 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// pkgCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
-	pkgCmd.Flags().StringP("apkbuild", "a", "", "APKBUILD to check")
+	pkgCmd.Flags().StringArrayP("apkbuild", "a", nil, "APKBUILD to check (can be repeated)")
 }
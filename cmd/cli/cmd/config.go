@@ -0,0 +1,276 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// profile - a named set of settings in the config file
+type profile struct {
+	Server  string `yaml:"server"`
+	Org     string `yaml:"org"`
+	Distro  string `yaml:"distro"`
+	Version string `yaml:"version"`
+	Repo    string `yaml:"repo"`
+	Arch    string `yaml:"arch"`
+	// Root - local package tree (the server's -dir) for commands that work on disk
+	Root string `yaml:"root"`
+	// Output - text, json or yaml
+	Output string `yaml:"output"`
+
+	// token sources, only one is used: the first one that's set, in this order
+	// $PKGS_TOKEN and --token-file/$PKGS_TOKEN_FILE override all of them
+	Token        string `yaml:"token"`
+	TokenEnv     string `yaml:"tokenEnv"`
+	TokenFile    string `yaml:"tokenFile"`
+	TokenCommand string `yaml:"tokenCommand"`
+}
+
+// configFile - the layout of the cli config file, e.g.
+//
+//	defaultProfile: prod
+//	profiles:
+//	  prod:
+//	    server: https://packages.atlascloud.xyz/api
+//	    org: atlascloud
+//	    tokenEnv: PKGS_TOKEN
+//	  local:
+//	    server: http://localhost:8888
+//	    tokenFile: ~/.config/atlascloud-packages/local-token
+type configFile struct {
+	DefaultProfile string             `yaml:"defaultProfile"`
+	Profiles       map[string]profile `yaml:"profiles"`
+}
+
+// builtin defaults, used when nothing else sets a value
+var defaultProfile = profile{
+	Server:   "https://packages.atlascloud.xyz/api",
+	Org:      "atlascloud",
+	Distro:   "alpine",
+	Version:  "edge",
+	Repo:     "main",
+	Arch:     "x86_64",
+	Root:     "/srv/packages",
+	Output:   outputText,
+	TokenEnv: "PKGS_TOKEN",
+}
+
+// settings - where each setting comes from, in order of precedence
+// flag > environment variable > profile > builtin default
+var settings = []struct {
+	flag      string
+	shorthand string
+	env       string
+	usage     string
+	field     func(*profile) *string
+}{
+	{"server", "", "PKGS_SERVER", "API server URL", func(p *profile) *string { return &p.Server }},
+	{"org", "", "PKGS_ORG", "organization", func(p *profile) *string { return &p.Org }},
+	{"distro", "", "PKGS_DISTRO", "distribution", func(p *profile) *string { return &p.Distro }},
+	{"version", "", "PKGS_VERSION", "distribution version", func(p *profile) *string { return &p.Version }},
+	{"repo", "", "PKGS_REPO", "repo", func(p *profile) *string { return &p.Repo }},
+	{"arch", "", "PKGS_ARCH", "architecture", func(p *profile) *string { return &p.Arch }},
+	{"root", "", "PKGS_ROOT", "local package root directory", func(p *profile) *string { return &p.Root }},
+	{"token-file", "", "PKGS_TOKEN_FILE", "file to read the API token from", func(p *profile) *string { return &p.TokenFile }},
+	{"output", "o", "PKGS_OUTPUT", "output format: text, json or yaml", func(p *profile) *string { return &p.Output }},
+}
+
+// cfg - the resolved settings, filled in before any command runs
+var cfg profile
+
+// explicitSettings - the settings that were set by the user instead of falling back to the default,
+// commands that can work on every org/repo/arch/etc only narrow things down when they were
+var explicitSettings = map[string]bool{}
+
+// explicitly - the value of a setting if the user set it, or "" if it's just the default
+func explicitly(flag string, value string) string {
+	if explicitSettings[flag] {
+		return value
+	}
+	return ""
+}
+
+// defaultConfigPath - $XDG_CONFIG_HOME/atlascloud-packages/config.yaml (or the platform equivalent)
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "atlascloud-packages", "config.yaml")
+}
+
+func expandHome(p string) string {
+	if strings.HasPrefix(p, "~/") {
+		home, err := os.UserHomeDir()
+		if err == nil {
+			return filepath.Join(home, p[2:])
+		}
+	}
+	return p
+}
+
+// loadConfigFile - read the config file, a missing file is only an error if it was asked for explicitly
+func loadConfigFile(path string, explicit bool) (configFile, error) {
+	var cf configFile
+	data, err := os.ReadFile(expandHome(path))
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return cf, nil
+	}
+	if err != nil {
+		return cf, err
+	}
+	err = yaml.Unmarshal(data, &cf)
+	if err != nil {
+		return cf, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return cf, nil
+}
+
+// resolveConfig - merge the builtin defaults, the selected profile, the environment and the flags
+func resolveConfig(cmd *cobra.Command) error {
+	flags := cmd.Flags()
+
+	configPath, _ := flags.GetString("config")
+	explicit := flags.Changed("config")
+	if !explicit {
+		if env := os.Getenv("PKGS_CONFIG"); env != "" {
+			configPath = env
+			explicit = true
+		}
+	}
+	if configPath == "" {
+		configPath = defaultConfigPath()
+	}
+	cf, err := loadConfigFile(configPath, explicit)
+	if err != nil {
+		return err
+	}
+
+	profileName, _ := flags.GetString("profile")
+	if !flags.Changed("profile") {
+		profileName = os.Getenv("PKGS_PROFILE")
+	}
+	if profileName == "" {
+		profileName = cf.DefaultProfile
+	}
+	var selected profile
+	if profileName != "" {
+		p, ok := cf.Profiles[profileName]
+		if !ok {
+			return fmt.Errorf("profile %q not found in %s", profileName, configPath)
+		}
+		selected = p
+	}
+
+	cfg = defaultProfile
+	explicitSettings = map[string]bool{}
+	for _, s := range settings {
+		value := *s.field(&selected)
+		if env := os.Getenv(s.env); env != "" {
+			value = env
+		}
+		if flags.Changed(s.flag) {
+			value, _ = flags.GetString(s.flag)
+		}
+		if value != "" {
+			*s.field(&cfg) = value
+			explicitSettings[s.flag] = true
+		}
+	}
+	// the token comes from exactly one source, so what else happens to be set can't change which
+	token := tokenSource(selected)
+	if flags.Changed("token-file") || os.Getenv("PKGS_TOKEN_FILE") != "" {
+		token = profile{TokenFile: cfg.TokenFile}
+	}
+	cfg.Token, cfg.TokenEnv, cfg.TokenFile, cfg.TokenCommand = token.Token, token.TokenEnv, token.TokenFile, token.TokenCommand
+	switch cfg.Output {
+	case outputText, outputJSON, outputYAML:
+	default:
+		return withExitCode(exitUsage, fmt.Errorf("unknown output format %q", cfg.Output))
+	}
+	cfg.Root = expandHome(cfg.Root)
+	cfg.TokenFile = expandHome(cfg.TokenFile)
+
+	return nil
+}
+
+// tokenSource - the first token source a profile sets, or the builtin default if it doesn't set any
+func tokenSource(p profile) profile {
+	switch {
+	case p.Token != "":
+		return profile{Token: p.Token}
+	case p.TokenEnv != "":
+		return profile{TokenEnv: p.TokenEnv}
+	case p.TokenFile != "":
+		return profile{TokenFile: p.TokenFile}
+	case p.TokenCommand != "":
+		return profile{TokenCommand: p.TokenCommand}
+	}
+	return profile{TokenEnv: defaultProfile.TokenEnv}
+}
+
+// apiToken - get the API token from whichever source is configured
+// PKGS_TOKEN always wins so CI can override whatever the profile says
+func apiToken() (string, error) {
+	if env := os.Getenv("PKGS_TOKEN"); env != "" {
+		return env, nil
+	}
+	switch {
+	case cfg.Token != "":
+		return cfg.Token, nil
+	case cfg.TokenEnv != "":
+		if env := os.Getenv(cfg.TokenEnv); env != "" {
+			return env, nil
+		}
+		return "", fmt.Errorf("no token found in %s", cfg.TokenEnv)
+	case cfg.TokenFile != "":
+		data, err := os.ReadFile(cfg.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case cfg.TokenCommand != "":
+		out, err := exec.Command("sh", "-c", cfg.TokenCommand).Output()
+		if err != nil {
+			return "", fmt.Errorf("failed to run token command: %w", err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	return "", errors.New("no token source configured")
+}
+
+func addConfigFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("config", "", "config file (default is "+defaultConfigPath()+", or $PKGS_CONFIG)")
+	cmd.PersistentFlags().StringP("profile", "p", "", "profile from the config file to use (or $PKGS_PROFILE)")
+	for _, s := range settings {
+		cmd.PersistentFlags().StringP(s.flag, s.shorthand, "", fmt.Sprintf("%s (or $%s)", s.usage, s.env))
+	}
+}
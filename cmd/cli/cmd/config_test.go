@@ -0,0 +1,95 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenPrecedence(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-token-*")
+	if err != nil {
+		t.Fatal("failed to create testTokenPrecedence tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testTokenPrecedence tmpDir", err)
+		}
+	}()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	assert.NoError(t, os.WriteFile(configPath, []byte(`profiles:
+  inline:
+    token: inline-token
+    tokenFile: `+filepath.Join(tmpDir, "profile-token")+`
+  file:
+    tokenFile: `+filepath.Join(tmpDir, "profile-token")+`
+    tokenCommand: echo command-token
+  command:
+    tokenCommand: echo command-token
+  env:
+    tokenEnv: MY_TOKEN
+  none:
+    org: other
+`), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "profile-token"), []byte("profile-file-token\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "flag-token"), []byte("flag-file-token\n"), 0600))
+	for _, env := range []string{"PKGS_TOKEN", "PKGS_TOKEN_FILE", "PKGS_PROFILE", "PKGS_CONFIG", "MY_TOKEN"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("MY_TOKEN", "env-token")
+
+	token := func(args ...string) string {
+		cmd := &cobra.Command{}
+		addConfigFlags(cmd)
+		assert.NoError(t, cmd.ParseFlags(append([]string{"--config", configPath}, args...)))
+		assert.NoError(t, resolveConfig(cmd))
+		token, err := apiToken()
+		assert.NoError(t, err)
+		return token
+	}
+
+	// the profile's sources in order, whichever it sets first
+	assert.Equal(t, "inline-token", token("-p", "inline"))
+	assert.Equal(t, "profile-file-token", token("-p", "file"))
+	assert.Equal(t, "command-token", token("-p", "command"))
+	assert.Equal(t, "env-token", token("-p", "env"))
+	// and $PKGS_TOKEN when it doesn't set any
+	t.Setenv("PKGS_TOKEN", "pkgs-token")
+	assert.Equal(t, "pkgs-token", token("-p", "none"))
+	// which beats everything
+	assert.Equal(t, "pkgs-token", token("-p", "inline"))
+	t.Setenv("PKGS_TOKEN", "")
+
+	// a token file from the flag or environment beats any of the profile's
+	t.Setenv("PKGS_TOKEN_FILE", filepath.Join(tmpDir, "flag-token"))
+	assert.Equal(t, "flag-file-token", token("-p", "inline"))
+	assert.Equal(t, "flag-file-token", token("-p", "command"))
+	t.Setenv("PKGS_TOKEN_FILE", "")
+	assert.Equal(t, "flag-file-token", token("-p", "env", "--token-file", filepath.Join(tmpDir, "flag-token")))
+}
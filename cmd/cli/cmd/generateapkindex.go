@@ -22,9 +22,8 @@ THE SOFTWARE.
 package cmd
 
 import (
-	"fmt"
-
 	api "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
@@ -38,9 +37,14 @@ and usage of using your command. For example:
 Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("generateapkindex called")
-		api.GenerateAPKIndex("/z/sites/packages/root/packages", "atlascloud", "alpine", "edge", "main", "x86_64")
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// progress goes to the log (stderr), stdout is only for command output
+		err := api.GenerateAPKIndex("file://"+cfg.Root, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, cfg.Arch)
+		if err != nil {
+			return err
+		}
+		log.Info().Str("org", cfg.Org).Str("repo", cfg.Repo).Str("arch", cfg.Arch).Msg("generated index")
+		return nil
 	},
 }
 
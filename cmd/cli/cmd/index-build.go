@@ -0,0 +1,279 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+// indexBuildCmd represents the index build command
+var indexBuildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Regenerate the APKINDEX files in a local package tree",
+	Long: `Walk the static/ dir of a local package tree (--root) and regenerate the
+APKINDEX.tar.gz of every org/distro/version/repo/arch, or just the ones
+selected with --org, --distro, --version, --repo and --arch. Settings that
+only come from the builtin defaults don't narrow anything down.
+
+Indexes are signed with the distro's keys in config/<org>/<distro>/ unless
+--key points at a different private key, the index is signed as <key name>.pub.
+An encrypted --key takes its passphrase from --key-passphrase-env or
+--key-passphrase-file. To keep the private key off this machine entirely, sign
+with --sign-command (the index goes to its stdin and the raw signature comes
+back on stdout, e.g. "openssl dgst -sha1 -sign key.rsa") or --sign-agent (an
+ssh-agent socket holding the key), both need --public-key, which also names
+the signature.
+
+With --check nothing is written, instead each index is compared with the
+packages next to it and any that are out of date are reported. Exits 1 if
+anything failed, or with --check, 3 if anything is out of date. For example:
+
+  cli index build --root /mnt/restore --org atlascloud --check`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+		check, _ := flags.GetBool("check")
+		signer, err := indexSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		basedir := "file://" + cfg.Root
+		filter := repoApi.RepoLocation{
+			Org:     explicitly("org", cfg.Org),
+			Distro:  explicitly("distro", cfg.Distro),
+			Version: explicitly("version", cfg.Version),
+			Repo:    explicitly("repo", cfg.Repo),
+			Arch:    explicitly("arch", cfg.Arch),
+		}
+		locs, err := repoApi.FindRepoLocations(basedir, filter)
+		if err != nil {
+			return fmt.Errorf("failed to walk package tree %s: %w", cfg.Root, err)
+		}
+		if len(locs) == 0 {
+			return withExitCode(exitMissing, fmt.Errorf("no repos found in %s", cfg.Root))
+		}
+
+		var results []indexResult
+		if check {
+			results = checkIndexes(basedir, locs)
+		} else {
+			results = buildIndexes(basedir, locs, signer)
+		}
+		err = render(results, func(w io.Writer) { printIndexResults(w, results, check) })
+		if err != nil {
+			return err
+		}
+
+		failed, stale := 0, 0
+		for _, r := range results {
+			switch {
+			case r.Error != "":
+				failed++
+			case !r.UpToDate:
+				stale++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d indexes failed", failed, len(results))
+		}
+		if stale > 0 {
+			return errProblemsFound
+		}
+		return nil
+	},
+}
+
+func locationName(loc repoApi.RepoLocation) string {
+	return path.Join(loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch)
+}
+
+// indexResult - what happened to one index
+type indexResult struct {
+	Location string `json:"location"`
+	Packages int    `json:"packages"`
+	Error    string `json:"error,omitempty"`
+	// the rest are only filled in by --check
+	HasIndex  bool     `json:"hasIndex"`
+	UpToDate  bool     `json:"upToDate"`
+	Unindexed []string `json:"unindexed,omitempty"`
+	Missing   []string `json:"missing,omitempty"`
+	Modified  []string `json:"modified,omitempty"`
+}
+
+// indexSigner - the signer picked with the flags, nil means the distro's own keys
+func indexSigner(cmd *cobra.Command) (repoApi.Signer, error) {
+	flags := cmd.Flags()
+	key, _ := flags.GetString("key")
+	passphraseEnv, _ := flags.GetString("key-passphrase-env")
+	passphraseFile, _ := flags.GetString("key-passphrase-file")
+	command, _ := flags.GetString("sign-command")
+	socket, _ := flags.GetString("sign-agent")
+	publicKey, _ := flags.GetString("public-key")
+
+	if key != "" {
+		passphrase, err := repoApi.KeyPassphrase(passphraseEnv, expandHome(passphraseFile))
+		if err != nil {
+			return nil, err
+		}
+		return repoApi.LoadKeySigner(expandHome(key), passphrase)
+	}
+	if command == "" && socket == "" {
+		return nil, nil
+	}
+	if publicKey == "" {
+		return nil, withExitCode(exitUsage, errors.New("--sign-command and --sign-agent need --public-key"))
+	}
+	public, err := repoApi.LoadPublicKey(expandHome(publicKey))
+	if err != nil {
+		return nil, err
+	}
+	if command != "" {
+		return repoApi.NewCommandSigner(filepath.Base(publicKey), public, strings.Fields(command)), nil
+	}
+	return repoApi.NewAgentSigner(filepath.Base(publicKey), expandHome(socket), public), nil
+}
+
+// buildIndexes - regenerate the index for each location, signer overrides the distro's keys
+func buildIndexes(basedir string, locs []repoApi.RepoLocation, signer repoApi.Signer) []indexResult {
+	var signers []repoApi.Signer
+	if signer != nil {
+		signers = append(signers, signer)
+	}
+	var results []indexResult
+	for _, loc := range locs {
+		r := indexResult{Location: locationName(loc)}
+		err := repoApi.GenerateAPKIndexWithSigners(basedir, loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch, signers...)
+		if err != nil {
+			r.Error = err.Error()
+			results = append(results, r)
+			continue
+		}
+		status, err := repoApi.CheckAPKIndex(basedir, loc)
+		if err != nil {
+			r.Error = "failed to read back index: " + err.Error()
+			results = append(results, r)
+			continue
+		}
+		r.Packages = status.Packages
+		r.HasIndex = true
+		r.UpToDate = true
+		results = append(results, r)
+	}
+	return results
+}
+
+// checkIndexes - compare each index with the packages on disk
+func checkIndexes(basedir string, locs []repoApi.RepoLocation) []indexResult {
+	var results []indexResult
+	for _, loc := range locs {
+		r := indexResult{Location: locationName(loc)}
+		status, err := repoApi.CheckAPKIndex(basedir, loc)
+		if err != nil {
+			r.Error = err.Error()
+			results = append(results, r)
+			continue
+		}
+		r.Packages = status.Packages
+		r.HasIndex = status.HasIndex
+		r.UpToDate = status.UpToDate()
+		r.Unindexed = status.Unindexed
+		r.Missing = status.Missing
+		r.Modified = status.Modified
+		results = append(results, r)
+	}
+	return results
+}
+
+// printIndexResults - the summary table, and for --check, what's wrong with each index
+func printIndexResults(w io.Writer, results []indexResult, check bool) {
+	var details []string
+	failed := 0
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if check {
+		fmt.Fprintln(tw, "LOCATION\tPACKAGES\tUNINDEXED\tMISSING\tMODIFIED\tRESULT")
+	} else {
+		fmt.Fprintln(tw, "LOCATION\tPACKAGES\tRESULT")
+	}
+	for _, r := range results {
+		switch {
+		case r.Error != "" && check:
+			fmt.Fprintf(tw, "%s\t-\t-\t-\t-\tfailed: %s\n", r.Location, r.Error)
+		case r.Error != "":
+			fmt.Fprintf(tw, "%s\t-\tfailed: %s\n", r.Location, r.Error)
+		case !check:
+			fmt.Fprintf(tw, "%s\t%d\tok\n", r.Location, r.Packages)
+		case !r.HasIndex:
+			fmt.Fprintf(tw, "%s\t%d\t-\t-\t-\tno index\n", r.Location, r.Packages)
+		default:
+			result := "up to date"
+			if !r.UpToDate {
+				result = "out of date"
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\n", r.Location, r.Packages, len(r.Unindexed), len(r.Missing), len(r.Modified), result)
+		}
+		if !r.UpToDate {
+			failed++
+		}
+		for _, f := range r.Unindexed {
+			details = append(details, fmt.Sprintf("%s: %s is not in the index", r.Location, f))
+		}
+		for _, f := range r.Missing {
+			details = append(details, fmt.Sprintf("%s: %s is in the index but not on disk", r.Location, f))
+		}
+		for _, f := range r.Modified {
+			details = append(details, fmt.Sprintf("%s: %s changed after the index was built", r.Location, f))
+		}
+	}
+	_ = tw.Flush()
+	if len(details) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, strings.Join(details, "\n"))
+	}
+	if check {
+		fmt.Fprintf(w, "\n%d indexes up to date, %d not\n", len(results)-failed, failed)
+	} else {
+		fmt.Fprintf(w, "\n%d indexes built, %d failed\n", len(results)-failed, failed)
+	}
+}
+
+func init() {
+	indexCmd.AddCommand(indexBuildCmd)
+
+	indexBuildCmd.Flags().String("key", "", "private key to sign the indexes with instead of the one in the config dir")
+	indexBuildCmd.Flags().String("key-passphrase-env", "", "environment variable holding the passphrase for an encrypted --key")
+	indexBuildCmd.Flags().String("key-passphrase-file", "", "file holding the passphrase for an encrypted --key")
+	indexBuildCmd.Flags().String("sign-command", "", "command that signs the index on stdin and writes the signature to stdout (split on spaces, not run through a shell)")
+	indexBuildCmd.Flags().String("sign-agent", "", "ssh-agent socket holding the key to sign with")
+	indexBuildCmd.Flags().String("public-key", "", "public key for --sign-command or --sign-agent, the signature is named after it")
+	indexBuildCmd.Flags().Bool("check", false, "only report indexes that are out of date with the packages on disk")
+	indexBuildCmd.MarkFlagsMutuallyExclusive("key", "sign-command", "sign-agent")
+	indexBuildCmd.MarkFlagsMutuallyExclusive("key-passphrase-env", "key-passphrase-file")
+}
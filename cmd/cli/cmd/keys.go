@@ -0,0 +1,280 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// keysCmd represents the keys command
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Manage the signing keys of a distro",
+	Long: `Manage the RSA keys the server signs --distro's indexes with.
+
+A rotation looks like:
+
+  cli keys create              # published, but not signing yet
+  (ship the new public key to clients, e.g. in your keyring package)
+  cli keys activate <new key>  # signs with both, the old key is now rotating
+  (wait until every client trusts the new key)
+  cli keys retire <old key>    # stops signing, leaves the keyring, the public key stays published
+
+Or "cli keys rotate" to create and activate a new key in one go. Indexes are
+signed with the new set of keys the next time they're generated.`,
+}
+
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the signing keys",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newAnonymousClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListSigningKeysWithResponse(context.Background(), cfg.Org, cfg.Distro)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("list signing keys", resp.StatusCode(), resp.Body)
+		}
+		keys := *resp.JSON200
+		return render(keys, func(w io.Writer) { printSigningKeys(w, keys) })
+	},
+}
+
+// createKey - create a key, used by both create and rotate
+func createKey(cmd *cobra.Command, activate bool) error {
+	bits, _ := cmd.Flags().GetInt("bits")
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.CreateSigningKeyWithResponse(context.Background(), cfg.Org, cfg.Distro, repoApi.CreateSigningKeyJSONRequestBody{Bits: &bits, Activate: &activate})
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError("create signing key", resp.StatusCode(), resp.Body)
+	}
+	key := *resp.JSON200
+	return render(key, func(w io.Writer) { printSigningKeys(w, []repoApi.SigningKey{key}) })
+}
+
+var keysCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Generate a new signing key and publish its public key",
+	Long: `Generate a new signing key and publish its public key. The key doesn't sign
+anything until it's activated, unless it's the distro's first key.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createKey(cmd, false)
+	},
+}
+
+var keysRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Generate a new signing key and make it active",
+	Long: `Generate a new signing key and make it active straight away. The previously
+active key keeps signing alongside it until it's retired.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createKey(cmd, true)
+	},
+}
+
+// keyStatusCommand - a command that sets a key to a fixed status
+func keyStatusCommand(use, short string, status repoApi.SigningKeyStatus) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <key name>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setKeyStatus(args[0], status)
+		},
+	}
+}
+
+func setKeyStatus(name string, status repoApi.SigningKeyStatus) error {
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.UpdateSigningKeyWithResponse(context.Background(), cfg.Org, cfg.Distro, name, repoApi.UpdateSigningKeyJSONRequestBody{Status: status})
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError("update signing key", resp.StatusCode(), resp.Body)
+	}
+	key := *resp.JSON200
+	return render(key, func(w io.Writer) { printSigningKeys(w, []repoApi.SigningKey{key}) })
+}
+
+var keysPubCmd = &cobra.Command{
+	Use:   "pub <key name>",
+	Short: "Download a public key",
+	Long: `Download a public key, by default to <key name>.rsa.pub in the current
+directory, or with --out - to stdout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out, _ := cmd.Flags().GetString("out")
+		client, err := newAnonymousClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetSigningKeyPublicKeyWithResponse(context.Background(), cfg.Org, cfg.Distro, args[0])
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return responseError("download public key", resp.StatusCode(), resp.Body)
+		}
+		if out == "" {
+			out = args[0] + ".rsa.pub"
+		}
+		return writeDownload(out, resp.Body)
+	},
+}
+
+var keysKeyringCmd = &cobra.Command{
+	Use:   "keyring",
+	Short: "Download the keyring package",
+	Long: `Download the <org>-keys package that installs every public key for --distro
+into /etc/apk/keys, by default to <org>-keys.apk in the current directory, or
+with --out - to stdout. The first time it's installed apk can't verify it yet:
+
+  apk add --allow-untrusted ./atlascloud-keys.apk`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out, _ := cmd.Flags().GetString("out")
+		client, err := newAnonymousClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetKeyringWithResponse(context.Background(), cfg.Org, cfg.Distro)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return responseError("download keyring", resp.StatusCode(), resp.Body)
+		}
+		if out == "" {
+			out = cfg.Org + "-keys.apk"
+		}
+		return writeDownload(out, resp.Body)
+	},
+}
+
+var keysPublishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "Publish every public key and rebuild the keyring package",
+	Long: `Publish the public half of every key for --distro and rebuild the <org>-keys
+package. Keys and keyrings are published whenever a key is created or changes
+status, this is for keys from before that, or ones that were copied in by hand.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.RebuildKeyringWithResponse(context.Background(), cfg.Org, cfg.Distro)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusNoContent {
+			return responseError("publish keys", resp.StatusCode(), resp.Body)
+		}
+		log.Info().Str("org", cfg.Org).Str("distro", cfg.Distro).Msg("published keys and rebuilt the keyring")
+		return nil
+	},
+}
+
+// writeDownload - write a downloaded file to path, or stdout for -
+func writeDownload(path string, data []byte) error {
+	if path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	err := os.WriteFile(path, data, 0644)
+	if err != nil {
+		return err
+	}
+	log.Info().Str("file", path).Int("bytes", len(data)).Msg("downloaded")
+	return nil
+}
+
+func printSigningKeys(w io.Writer, keys []repoApi.SigningKey) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSTATUS\tBITS\tCREATED\tVALID FROM\tVALID UNTIL\tFINGERPRINT")
+	date := func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.Format("2006-01-02")
+	}
+	for _, k := range keys {
+		bits, fingerprint := "-", "-"
+		if k.Bits != nil {
+			bits = fmt.Sprint(*k.Bits)
+		}
+		if k.Fingerprint != nil {
+			fingerprint = *k.Fingerprint
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", k.Name, k.Status, bits, date(k.Created), date(k.ValidFrom), date(k.ValidUntil), fingerprint)
+	}
+	_ = tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(keysCmd)
+
+	keysCmd.AddCommand(keysListCmd)
+	keysCmd.AddCommand(keysCreateCmd)
+	keysCmd.AddCommand(keysRotateCmd)
+	keysCmd.AddCommand(keyStatusCommand("activate", "Make a key the active signing key, the old one keeps signing until it's retired", repoApi.Active))
+	keysCmd.AddCommand(keyStatusCommand("retire", "Stop signing with a key, its public key stays published", repoApi.Retired))
+	keysCmd.AddCommand(keyStatusCommand("deactivate", "Stop signing with a key that isn't active yet, or an old key before retiring it", repoApi.Inactive))
+
+	keysCmd.AddCommand(keysPubCmd)
+	keysCmd.AddCommand(keysKeyringCmd)
+	keysCmd.AddCommand(keysPublishCmd)
+
+	for _, c := range []*cobra.Command{keysCreateCmd, keysRotateCmd} {
+		c.Flags().Int("bits", 4096, "key size")
+	}
+	for _, c := range []*cobra.Command{keysPubCmd, keysKeyringCmd} {
+		c.Flags().StringP("out", "O", "", "where to write the file, - for stdout")
+	}
+}
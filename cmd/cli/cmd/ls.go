@@ -0,0 +1,128 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+// lsCmd represents the ls command
+var lsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List what's on the server",
+	Long: `List the orgs, distros, versions, repos, arches or packages on the server,
+following the paginated listings to the end. Each level is listed inside
+the configured --org, --distro, --version, --repo and --arch.`,
+}
+
+// lsCommand - a subcommand of ls that lists one kind of thing, text output is one name per line
+// connect is newClient, or newAnonymousClient for listings that don't need a token
+func lsCommand[T any](use, short string, connect func() (*repoApi.ClientWithResponses, error), list func(ctx context.Context, client *repoApi.ClientWithResponses) ([]T, error), name func(T) string) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := connect()
+			if err != nil {
+				return err
+			}
+			items, err := list(context.Background(), client)
+			if err != nil {
+				return err
+			}
+			return render(items, func(w io.Writer) {
+				for _, item := range items {
+					fmt.Fprintln(w, name(item))
+				}
+			})
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(lsCmd)
+
+	lsCmd.AddCommand(lsCommand("orgs", "List the orgs", newAnonymousClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.Organization, error) {
+			return allPages("list orgs", func(limit *int, cursor *string) (page[repoApi.Organization], error) {
+				resp, err := client.ListOrganizationsWithResponse(ctx, &repoApi.ListOrganizationsParams{Limit: limit, Cursor: cursor})
+				if err != nil {
+					return page[repoApi.Organization]{}, err
+				}
+				return page[repoApi.Organization]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+			})
+		},
+		func(o repoApi.Organization) string {
+			if o.Name == nil {
+				return ""
+			}
+			return *o.Name
+		}))
+
+	lsCmd.AddCommand(lsCommand("distros", "List the distros in --org", newClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.Distribution, error) {
+			return allPages("list distros", func(limit *int, cursor *string) (page[repoApi.Distribution], error) {
+				resp, err := client.ListDistrosWithResponse(ctx, cfg.Org, &repoApi.ListDistrosParams{Limit: limit, Cursor: cursor})
+				if err != nil {
+					return page[repoApi.Distribution]{}, err
+				}
+				return page[repoApi.Distribution]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+			})
+		},
+		func(d repoApi.Distribution) string { return d }))
+
+	lsCmd.AddCommand(lsCommand("versions", "List the versions of --distro", newClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.RepoVersion, error) {
+			return allPages("list versions", func(limit *int, cursor *string) (page[repoApi.RepoVersion], error) {
+				resp, err := client.ListVersionsWithResponse(ctx, cfg.Org, cfg.Distro, &repoApi.ListVersionsParams{Limit: limit, Cursor: cursor})
+				if err != nil {
+					return page[repoApi.RepoVersion]{}, err
+				}
+				return page[repoApi.RepoVersion]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+			})
+		},
+		func(v repoApi.RepoVersion) string { return v }))
+
+	lsCmd.AddCommand(lsCommand("repos", "List the repos in --distro/--version", newClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.Repo, error) {
+			return listAllRepos(ctx, client, cfg.Org, cfg.Distro, cfg.Version)
+		},
+		func(r repoApi.Repo) string { return r.Name }))
+
+	lsCmd.AddCommand(lsCommand("arches", "List the arches in --repo", newClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.Architecture, error) {
+			return listAllArches(ctx, client, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo)
+		},
+		func(a repoApi.Architecture) string { return a }))
+
+	lsCmd.AddCommand(lsCommand("pkgs", "List the packages in --repo/--arch", newClient,
+		func(ctx context.Context, client *repoApi.ClientWithResponses) ([]repoApi.Package, error) {
+			return listAllPackages(ctx, client, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, cfg.Arch)
+		},
+		func(p repoApi.Package) string { return p.Name }))
+}
@@ -0,0 +1,140 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+// mirrorCmd represents the mirror command
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Check on and sync a repo that mirrors an upstream repo",
+	Long: `Check on and sync --repo when it mirrors an upstream apk repo. Mirrors are set
+up on the server with config/<org>/<distro>/<version>/<repo>/mirror.yaml, the
+server syncs them in the background every interval.`,
+}
+
+var mirrorStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show how the last sync of each arch went",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetMirrorStatusWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("get mirror status", resp.StatusCode(), resp.Body)
+		}
+		status := *resp.JSON200
+		return render(status, func(w io.Writer) { printMirrorStatus(w, status) })
+	},
+}
+
+var mirrorSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync the mirror now",
+	Long: `Sync the mirror now rather than waiting for the next interval. With --wait
+this exits with an error if any arch failed to sync.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		wait, _ := cmd.Flags().GetBool("wait")
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.SyncMirrorWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, &repoApi.SyncMirrorParams{Wait: &wait})
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("sync mirror", resp.StatusCode(), resp.Body)
+		}
+		status := *resp.JSON200
+		err = render(status, func(w io.Writer) { printMirrorStatus(w, status) })
+		if err != nil {
+			return err
+		}
+		if wait {
+			for _, a := range status.Arches {
+				if a.Error != nil {
+					return withExitCode(exitError, errors.New("mirror sync failed"))
+				}
+			}
+		}
+		return nil
+	},
+}
+
+func printMirrorStatus(w io.Writer, status repoApi.MirrorStatus) {
+	state := "idle"
+	if status.Syncing {
+		state = "syncing"
+	}
+	fmt.Fprintf(w, "upstream: %s (%s)\n", status.Upstream, state)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ARCH\tLAST SYNC\tLAST SUCCESS\tPACKAGES\tDOWNLOADED\tREMOVED\tSIGNED BY\tERROR")
+	date := func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.Local().Format("2006-01-02 15:04")
+	}
+	str := func(s *string) string {
+		if s == nil || *s == "" {
+			return "-"
+		}
+		return *s
+	}
+	num := func(n *int) string {
+		if n == nil {
+			return "-"
+		}
+		return fmt.Sprint(*n)
+	}
+	for _, a := range status.Arches {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", a.Arch, date(a.LastSync), date(a.LastSuccess), num(a.Packages), num(a.Downloaded), num(a.Removed), str(a.SignedBy), str(a.Error))
+	}
+	_ = tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(mirrorCmd)
+
+	mirrorCmd.AddCommand(mirrorStatusCmd)
+	mirrorCmd.AddCommand(mirrorSyncCmd)
+
+	mirrorSyncCmd.Flags().Bool("wait", false, "wait for the sync to finish")
+}
@@ -0,0 +1,152 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"gopkg.in/yaml.v3"
+)
+
+// exit codes, so scripts can tell what went wrong without parsing the output
+const (
+	exitOK = 0
+	// exitError - anything that doesn't have a more specific code (bad config, local files, etc)
+	exitError = 1
+	// exitUsage - bad flags or arguments
+	exitUsage = 2
+	// exitMissing - the command worked, but something wasn't there (a missing or outdated
+	// package, an out of date index, broken dependencies, a 404)
+	exitMissing = 3
+	// exitAuth - no token, or the server rejected it
+	exitAuth = 4
+	// exitServer - the server couldn't be reached or returned a 5xx
+	exitServer = 5
+)
+
+// output formats
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// cliError - an error along with the exit code it should produce
+type cliError struct {
+	code int
+	err  error
+}
+
+func (e *cliError) Error() string { return e.err.Error() }
+func (e *cliError) Unwrap() error { return e.err }
+
+func withExitCode(code int, err error) error {
+	return &cliError{code: code, err: err}
+}
+
+// errProblemsFound - returned by commands that already printed what's wrong and just need to
+// exit with exitMissing
+var errProblemsFound = withExitCode(exitMissing, errors.New("problems found"))
+
+// exitCode - the exit code for an error returned by a command
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	var ce *cliError
+	if errors.As(err, &ce) {
+		return ce.code
+	}
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return exitServer
+	}
+	return exitError
+}
+
+// responseError - turn an unexpected API response into an error with the matching exit code
+func responseError(what string, status int, body []byte) error {
+	msg := http.StatusText(status)
+	var apiErr repoApi.Error
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+		msg = apiErr.Message
+	}
+	err := fmt.Errorf("failed to %s: server returned %d: %s", what, status, msg)
+
+	code := exitError
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		code = exitAuth
+	case status == http.StatusNotFound:
+		code = exitMissing
+	case status >= http.StatusInternalServerError:
+		code = exitServer
+	}
+	return withExitCode(code, err)
+}
+
+// render - write v to stdout in the configured output format, text uses the given function
+// since every command formats its text differently
+func render(v any, text func(w io.Writer)) error {
+	switch cfg.Output {
+	case outputJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case outputYAML:
+		// go through json so the yaml keys match the json ones (the API types only have json tags)
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var node yaml.Node
+		err = yaml.Unmarshal(data, &node)
+		if err != nil {
+			return err
+		}
+		clearStyle(&node)
+		enc := yaml.NewEncoder(os.Stdout)
+		enc.SetIndent(2)
+		err = enc.Encode(&node)
+		if err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	text(os.Stdout)
+	return nil
+}
+
+// clearStyle - json is flow style yaml with every string quoted, reset it to the block style
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		clearStyle(n)
+	}
+}
@@ -0,0 +1,575 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+	"golang.org/x/sync/errgroup"
+)
+
+// pushCmd represents the push command
+var pushCmd = &cobra.Command{
+	Use:   "push <files or globs>...",
+	Short: "Upload packages to the server",
+	Long: `Upload .apk files to the configured org/distro/version/repo.
+
+Each package goes to the arch directory that matches the arch in its
+.PKGINFO (noarch packages go to --arch, or with --all-arches to every arch
+the repo has, and the server regenerates their indexes itself). Packages that
+already exist on the server are skipped unless --force is set. With --index
+the index of every arch that got new packages is regenerated once all the
+uploads are done. Packages bigger than --chunk-size are sent in chunks, and
+a retry carries on from the last chunk that made it instead of starting over.
+With --transaction nothing is published unless every package made it, and
+then they're all published at once with one index regeneration per arch.
+
+Progress goes to stderr and the summary (or with --output, the result for
+every package) to stdout. Exits non-zero if anything failed. For example:
+
+  cli push --repo testing --index ~/packages/testing/x86_64/*.apk`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+		concurrency, _ := flags.GetInt("concurrency")
+		retries, _ := flags.GetInt("retries")
+		force, _ := flags.GetBool("force")
+		index, _ := flags.GetBool("index")
+		wait, _ := flags.GetBool("wait")
+		allArches, _ := flags.GetBool("all-arches")
+		chunkMB, _ := flags.GetInt64("chunk-size")
+		transaction, _ := flags.GetBool("transaction")
+
+		if transaction && allArches {
+			return withExitCode(exitUsage, errors.New("--transaction and --all-arches can't be used together"))
+		}
+		files, err := expandPackageArgs(args)
+		if err != nil {
+			return withExitCode(exitUsage, err)
+		}
+		noarchTarget := cfg.Arch
+		if allArches {
+			noarchTarget = everyArch
+		}
+		byArch, err := groupPackagesByArch(files, noarchTarget)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		opts := pushOptions{concurrency: concurrency, retries: retries, force: force, chunkSize: chunkMB << 20}
+		if transaction {
+			opts.transaction, err = openTransaction(ctx, client)
+			if err != nil {
+				return err
+			}
+		}
+		result, errs := pushPackages(ctx, client, byArch, opts)
+
+		if transaction {
+			// all or nothing, and committing regenerates the indexes itself
+			indexed, err := finishTransaction(ctx, client, opts.transaction, len(errs) == 0)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			result.Indexed = append(result.Indexed, indexed...)
+		} else if index {
+			for _, arch := range sortedKeys(byArch) {
+				if arch == everyArch {
+					continue
+				}
+				err := regenerateIndex(ctx, client, arch, wait)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to regenerate index for %s: %w", arch, err))
+					continue
+				}
+				result.Indexed = append(result.Indexed, arch)
+				fmt.Fprintf(os.Stderr, "regenerated index for %s/%s\n", cfg.Repo, arch)
+			}
+		}
+
+		err = render(result, func(w io.Writer) {
+			fmt.Fprintf(w, "%d uploaded, %d skipped, %d failed\n", len(result.Uploaded), len(result.Skipped), len(result.Failed))
+		})
+		if err != nil {
+			return err
+		}
+		// the first error decides the exit code, e.g. a rejected token fails every upload the same way
+		return errors.Join(errs...)
+	},
+}
+
+// everyArch - the arch noarch packages are grouped under with --all-arches, they're uploaded
+// once and the server adds them to every arch
+const everyArch = "*"
+
+type pushOptions struct {
+	concurrency int
+	retries     int
+	force       bool
+	// chunkSize - packages bigger than this go up in chunks through a resumable upload, 0 never
+	chunkSize int64
+	// transaction - the transaction to upload into, if there is one
+	transaction string
+}
+
+// pushedPackage - a package push handled, along with the arch dir it went to
+type pushedPackage struct {
+	File  string `json:"file"`
+	Arch  string `json:"arch"`
+	Error string `json:"error,omitempty"`
+}
+
+// pushResult - what push did with each package
+type pushResult struct {
+	Uploaded []pushedPackage `json:"uploaded"`
+	Skipped  []pushedPackage `json:"skipped"`
+	Failed   []pushedPackage `json:"failed"`
+	// Indexed - the arches whose index was regenerated
+	Indexed []string `json:"indexed"`
+}
+
+// expandPackageArgs - expand any globs in the args, plain paths have to exist
+func expandPackageArgs(args []string) ([]string, error) {
+	var files []string
+	seen := map[string]bool{}
+	for _, arg := range args {
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("bad pattern %q: %w", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no packages match %q", arg)
+		}
+		for _, m := range matches {
+			if !seen[m] && strings.HasSuffix(m, ".apk") {
+				seen[m] = true
+				files = append(files, m)
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no .apk files to push")
+	}
+	return files, nil
+}
+
+// groupPackagesByArch - parse each package and group them by the arch they get uploaded to
+func groupPackagesByArch(files []string, noarchTarget string) (map[string][]string, error) {
+	result := map[string][]string{}
+	for _, f := range files {
+		fd, err := os.Open(f)
+		if err != nil {
+			return nil, err
+		}
+		pkg, err := repository.ParsePackage(fd)
+		closeErr := fd.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", f, err)
+		}
+		if closeErr != nil {
+			return nil, closeErr
+		}
+		arch := pkg.Arch
+		if arch == "noarch" || arch == "" {
+			arch = noarchTarget
+		}
+		result[arch] = append(result[arch], f)
+	}
+	return result, nil
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// pushPackages - upload everything in byArch, along with the errors for anything that failed
+func pushPackages(ctx context.Context, client *repoApi.ClientWithResponses, byArch map[string][]string, opts pushOptions) (pushResult, []error) {
+	result := pushResult{Uploaded: []pushedPackage{}, Skipped: []pushedPackage{}, Failed: []pushedPackage{}, Indexed: []string{}}
+	var errs []error
+	var jobs []pushedPackage
+	for _, arch := range sortedKeys(byArch) {
+		existing := map[string]bool{}
+		// there's nothing to list for everyArch, the server just replaces them
+		if !opts.force && arch != everyArch {
+			pkgs, err := listAllPackages(ctx, client, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to list existing %s packages: %w", arch, err))
+				for _, f := range byArch[arch] {
+					result.Failed = append(result.Failed, pushedPackage{File: f, Arch: arch, Error: err.Error()})
+				}
+				continue
+			}
+			for _, p := range pkgs {
+				existing[p.Name] = true
+			}
+		}
+		for _, f := range byArch[arch] {
+			if existing[filepath.Base(f)] {
+				fmt.Fprintf(os.Stderr, "skipping %s, already on the server in %s/%s\n", filepath.Base(f), cfg.Repo, arch)
+				result.Skipped = append(result.Skipped, pushedPackage{File: f, Arch: arch})
+				continue
+			}
+			jobs = append(jobs, pushedPackage{File: f, Arch: arch})
+		}
+	}
+
+	var done atomic.Int32
+	var mu sync.Mutex // protects the results and keeps progress lines from interleaving
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(opts.concurrency, 1))
+	for _, j := range jobs {
+		g.Go(func() error {
+			err := uploadWithRetries(gctx, client, j.Arch, j.File, opts)
+			n := done.Add(1)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				j.Error = err.Error()
+				result.Failed = append(result.Failed, j)
+				errs = append(errs, fmt.Errorf("failed to upload %s: %w", filepath.Base(j.File), err))
+				fmt.Fprintf(os.Stderr, "[%d/%d] FAILED %s (%s): %v\n", n, len(jobs), filepath.Base(j.File), j.Arch, err)
+				return nil
+			}
+			result.Uploaded = append(result.Uploaded, j)
+			fmt.Fprintf(os.Stderr, "[%d/%d] uploaded %s (%s)\n", n, len(jobs), filepath.Base(j.File), j.Arch)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return result, errs
+}
+
+// errPermanent - an upload failure that retrying won't fix
+type errPermanent struct {
+	err error
+}
+
+func (e errPermanent) Error() string { return e.err.Error() }
+func (e errPermanent) Unwrap() error { return e.err }
+
+func uploadWithRetries(ctx context.Context, client *repoApi.ClientWithResponses, arch, file string, opts pushOptions) error {
+	chunked := false
+	if opts.chunkSize > 0 && arch != everyArch && opts.transaction == "" {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		chunked = info.Size() > opts.chunkSize
+	}
+	// a chunked upload's session is kept between attempts, so they carry on where the last one got to
+	var session string
+	var err error
+	backoff := time.Second
+	for attempt := 0; attempt <= opts.retries; attempt++ {
+		if attempt > 0 {
+			log.Warn().Err(err).Str("file", file).Int("attempt", attempt).Msg("retrying upload")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		if chunked {
+			err = uploadChunked(ctx, client, arch, file, opts.chunkSize, &session)
+		} else {
+			err = uploadPackage(ctx, client, arch, file, opts.transaction)
+		}
+		var perm errPermanent
+		if err == nil || errors.As(err, &perm) {
+			return err
+		}
+	}
+	return err
+}
+
+// uploadPackage - stream a package to the server
+func uploadPackage(ctx context.Context, client *repoApi.ClientWithResponses, arch, file, transaction string) error {
+	fd, err := os.Open(file)
+	if err != nil {
+		return errPermanent{err}
+	}
+	defer func() {
+		_ = fd.Close()
+	}()
+
+	var status int
+	var body []byte
+	switch {
+	case arch == everyArch:
+		status, body, err = uploadNoarchPackage(ctx, client, fd)
+	case transaction != "":
+		var resp *repoApi.UploadTransactionFileResponse
+		resp, err = client.UploadTransactionFileWithBodyWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, transaction, arch, filepath.Base(file), "application/octet-stream", fd, withContentLength(fd))
+		if err == nil {
+			status, body = resp.StatusCode(), resp.Body
+		}
+	default:
+		// the package is the whole body, so the server can stream it straight to storage
+		var resp *repoApi.UploadRepoFileResponse
+		resp, err = client.UploadRepoFileWithBodyWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, filepath.Base(file), "application/octet-stream", fd, withContentLength(fd))
+		if err == nil {
+			status, body = resp.StatusCode(), resp.Body
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+	return failedResponse("upload package", status, body)
+}
+
+// uploadNoarchPackage - upload a noarch package to every arch, as a multipart form
+func uploadNoarchPackage(ctx context.Context, client *repoApi.ClientWithResponses, fd *os.File) (int, []byte, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreateFormFile("package", filepath.Base(fd.Name()))
+		if err == nil {
+			_, err = io.Copy(part, fd)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	resp, err := client.CreateNoarchPackageWithBodyWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, mw.FormDataContentType(), pr)
+	// make sure the writer goroutine doesn't hang around if the request bailed early
+	_ = pr.Close()
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode(), resp.Body, nil
+}
+
+// withContentLength - send the file's size, so the server can turn away one that's too big before
+// it's sent
+func withContentLength(fd *os.File) repoApi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		info, err := fd.Stat()
+		if err == nil {
+			req.ContentLength = info.Size()
+		}
+		return nil
+	}
+}
+
+// failedResponse - the error for a response that wasn't what we wanted, only 5xxs are worth
+// retrying
+func failedResponse(what string, status int, body []byte) error {
+	err := responseError(what, status, body)
+	if status < http.StatusInternalServerError {
+		return errPermanent{err}
+	}
+	return err
+}
+
+// uploadChunked - upload a package in chunks through a resumable upload session, starting one if
+// *session is empty and carrying on from however much the server already has otherwise. *session
+// is cleared when the session's gone (or no use any more), so the next attempt starts over
+func uploadChunked(ctx context.Context, client *repoApi.ClientWithResponses, arch, file string, chunkSize int64, session *string) error {
+	fd, err := os.Open(file)
+	if err != nil {
+		return errPermanent{err}
+	}
+	defer func() {
+		_ = fd.Close()
+	}()
+	info, err := fd.Stat()
+	if err != nil {
+		return errPermanent{err}
+	}
+	h := sha256.New()
+	_, err = io.Copy(h, fd)
+	if err != nil {
+		return errPermanent{err}
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	if *session == "" {
+		size := info.Size()
+		resp, err := client.CreateUploadSessionWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, repoApi.NewUploadSession{File: filepath.Base(file), Size: &size})
+		if err != nil {
+			return err
+		}
+		if resp.JSON201 == nil {
+			return failedResponse("start upload", resp.StatusCode(), resp.Body)
+		}
+		*session = resp.JSON201.Id
+	}
+	errExpired := fmt.Errorf("upload session %s expired", *session)
+
+	resp, err := client.GetUploadSessionWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, *session)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		*session = ""
+		return errExpired
+	}
+	if resp.JSON200 == nil {
+		return failedResponse("get upload progress", resp.StatusCode(), resp.Body)
+	}
+	offset := resp.JSON200.Offset
+	for offset < info.Size() {
+		n := min(chunkSize, info.Size()-offset)
+		resp, err := client.UploadChunkWithBodyWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, *session, &repoApi.UploadChunkParams{Offset: offset}, "application/octet-stream", io.NewSectionReader(fd, offset, n), func(ctx context.Context, req *http.Request) error {
+			req.ContentLength = n
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		switch {
+		case resp.JSON200 != nil:
+			offset = resp.JSON200.Offset
+		case resp.StatusCode() == http.StatusNotFound:
+			*session = ""
+			return errExpired
+		case resp.StatusCode() == http.StatusConflict, resp.StatusCode() == http.StatusBadRequest:
+			// the chunk was cut off, or the server has a different idea of how much has arrived,
+			// either way the next attempt asks it where to carry on from
+			return responseError("upload chunk", resp.StatusCode(), resp.Body)
+		default:
+			return failedResponse("upload chunk", resp.StatusCode(), resp.Body)
+		}
+	}
+
+	fin, err := client.FinalizeUploadWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, *session, repoApi.FinalizeUpload{Sha256: sum})
+	if err != nil {
+		return err
+	}
+	switch fin.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		*session = ""
+		return errExpired
+	case http.StatusBadRequest:
+		// the server threw the session away because what it got didn't match, so start over
+		*session = ""
+		return responseError("finish upload", fin.StatusCode(), fin.Body)
+	case http.StatusConflict:
+		return responseError("finish upload", fin.StatusCode(), fin.Body)
+	}
+	return failedResponse("finish upload", fin.StatusCode(), fin.Body)
+}
+
+// openTransaction - open an upload transaction for the repo
+func openTransaction(ctx context.Context, client *repoApi.ClientWithResponses) (string, error) {
+	resp, err := client.CreateTransactionWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo)
+	if err != nil {
+		return "", err
+	}
+	if resp.JSON201 == nil {
+		return "", responseError("open transaction", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON201.Id, nil
+}
+
+// finishTransaction - commit the transaction, or abort it if not everything made it into it,
+// returning the arches whose indexes were regenerated
+func finishTransaction(ctx context.Context, client *repoApi.ClientWithResponses, id string, commit bool) ([]string, error) {
+	if !commit {
+		resp, err := client.AbortTransactionWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, id)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusNoContent {
+			return nil, responseError("abort transaction", resp.StatusCode(), resp.Body)
+		}
+		fmt.Fprintf(os.Stderr, "aborted transaction %s, nothing was published\n", id)
+		return nil, nil
+	}
+	resp, err := client.CommitTransactionWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError("commit transaction", resp.StatusCode(), resp.Body)
+	}
+	var arches []string
+	for _, f := range resp.JSON200.Files {
+		if len(arches) == 0 || arches[len(arches)-1] != f.Arch {
+			arches = append(arches, f.Arch)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "committed transaction %s, regenerated the index for %s\n", id, strings.Join(arches, ", "))
+	return arches, nil
+}
+
+// regenerateIndex - ask the server to rebuild the index for an arch, optionally waiting for it
+func regenerateIndex(ctx context.Context, client *repoApi.ClientWithResponses, arch string, wait bool) error {
+	resp, err := client.CreatePackageIndexWithResponse(ctx, cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, arch, &repoApi.CreatePackageIndexParams{Wait: &wait})
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil || !resp.JSON200.Status {
+		return responseError("regenerate index", resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(pushCmd)
+
+	pushCmd.Flags().IntP("concurrency", "j", 4, "number of packages to upload at once")
+	pushCmd.Flags().Int("retries", 3, "number of times to retry a failed upload")
+	pushCmd.Flags().BoolP("force", "f", false, "upload packages even if they already exist on the server")
+	pushCmd.Flags().Bool("index", false, "regenerate the index of each arch after uploading")
+	pushCmd.Flags().Bool("wait", true, "wait for index regeneration to finish (with --index)")
+	pushCmd.Flags().Bool("all-arches", false, "add noarch packages to every arch in the repo instead of just --arch")
+	pushCmd.Flags().Bool("transaction", false, "publish all the packages at once (and regenerate each index once) only if every upload succeeds")
+	pushCmd.Flags().Int64("chunk-size", 64, "upload packages bigger than this many MiB in chunks that are resumed after a failure, 0 to never")
+}
@@ -22,8 +22,10 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"errors"
 	"os"
 
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
@@ -40,15 +42,31 @@ to quickly create a Cobra application.`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	// Run: func(cmd *cobra.Command, args []string) { },
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// the flags and args were fine, so any error from here on isn't a usage problem
+		cmd.SilenceUsage = true
+		return resolveConfig(cmd)
+	},
+	// errors get logged by Execute instead
+	SilenceErrors: true,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
-	if err != nil {
-		os.Exit(1)
+	cmd, err := rootCmd.ExecuteC()
+	if err == nil {
+		return
 	}
+	code := exitCode(err)
+	if !cmd.SilenceUsage {
+		// cobra bailed out while parsing the flags or args, before the command ran
+		code = exitUsage
+	}
+	if !errors.Is(err, errProblemsFound) {
+		log.Error().Err(err).Int("exit code", code).Msg(cmd.CommandPath() + " failed")
+	}
+	os.Exit(code)
 }
 
 func init() {
@@ -56,7 +74,7 @@ func init() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.cli.yaml)")
+	addConfigFlags(rootCmd)
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -0,0 +1,188 @@
+/*
+Copyright © 2026 Iggy Jackson <iggy@iggy.ninja>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"text/tabwriter"
+
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Create, list and delete snapshots of a repo",
+	Long: `Snapshots pin --repo as it is now, its indexes and every package in them, so
+it can be installed from later no matter what happens to the repo. A snapshot
+is served at
+
+  <server>/<org>/<distro>/<version>/<repo>/snapshots/<name>
+
+so that's what goes in /etc/apk/repositories.`,
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Snapshot the repo as it is now",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		arches, _ := cmd.Flags().GetStringSlice("arch")
+		description, _ := cmd.Flags().GetString("description")
+		body := repoApi.NewSnapshot{Name: args[0]}
+		if len(arches) > 0 {
+			body.Arches = &arches
+		}
+		if description != "" {
+			body.Description = &description
+		}
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateSnapshotWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, body)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("create snapshot", resp.StatusCode(), resp.Body)
+		}
+		snapshot := *resp.JSON200
+		return render(snapshot, func(w io.Writer) { printSnapshots(w, []repoApi.Snapshot{snapshot}) })
+	},
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the repo's snapshots",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListSnapshotsWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("list snapshots", resp.StatusCode(), resp.Body)
+		}
+		snapshots := *resp.JSON200
+		return render(snapshots, func(w io.Writer) { printSnapshots(w, snapshots) })
+	},
+}
+
+var snapshotShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show the packages in a snapshot",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetSnapshotWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, args[0])
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError("get snapshot", resp.StatusCode(), resp.Body)
+		}
+		snapshot := *resp.JSON200
+		return render(snapshot, func(w io.Writer) {
+			printSnapshots(w, []repoApi.Snapshot{snapshot})
+			fmt.Fprintln(w)
+			tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "ARCH\tFILE\tSIZE\tSHA256")
+			for _, a := range snapshot.Arches {
+				if a.Packages == nil {
+					continue
+				}
+				for _, p := range *a.Packages {
+					fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", a.Arch, p.File, p.Size, p.Sha256)
+				}
+			}
+			_ = tw.Flush()
+		})
+	},
+}
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a snapshot",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		resp, err := client.DeleteSnapshotWithResponse(context.Background(), cfg.Org, cfg.Distro, cfg.Version, cfg.Repo, args[0])
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusNoContent {
+			return responseError("delete snapshot", resp.StatusCode(), resp.Body)
+		}
+		log.Info().Str("snapshot", args[0]).Msg("deleted")
+		return nil
+	},
+}
+
+func printSnapshots(w io.Writer, snapshots []repoApi.Snapshot) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCREATED\tARCHES\tPACKAGES\tDESCRIPTION")
+	for _, s := range snapshots {
+		var arches string
+		packages := 0
+		for i, a := range s.Arches {
+			if i > 0 {
+				arches += ","
+			}
+			arches += a.Arch
+			packages += a.PackageCount
+		}
+		description := "-"
+		if s.Description != nil && *s.Description != "" {
+			description = *s.Description
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n", s.Name, s.Created.Local().Format("2006-01-02 15:04"), arches, packages, description)
+	}
+	_ = tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotCmd.AddCommand(snapshotShowCmd)
+	snapshotCmd.AddCommand(snapshotDeleteCmd)
+
+	snapshotCreateCmd.Flags().StringSlice("arch", nil, "only snapshot these arches (default all of them)")
+	snapshotCreateCmd.Flags().String("description", "", "what the snapshot is for")
+}
@@ -22,31 +22,98 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"path"
+	"net/http"
 
-	"github.com/rs/zerolog/log"
-	"gitlab.alpinelinux.org/alpine/go/apkbuild"
+	repoApi "github.com/atlascloud/packages/internal/openapi"
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 )
 
-func getApkNameFromApkBuild(apkBuildPath string) (string, error) {
-	// get info from the APKBUILD to check against what the API says already exists
-	fp, err := os.Open(apkBuildPath)
+// listPageSize - how many entries to ask for per request when following the paginated listings
+const listPageSize = 1000
+
+// newClient - create an API client for the configured server and token
+func newClient() (*repoApi.ClientWithResponses, error) {
+	pkgsToken, err := apiToken()
 	if err != nil {
-		log.Error().Err(err).Msg("failed to open apkbuild file")
-	}
-	abf := apkbuild.ApkbuildFile{
-		PackageName: path.Base(path.Dir(apkBuildPath)),
-		Content:     fp,
+		return nil, withExitCode(exitAuth, err)
 	}
-	parsed, err := apkbuild.Parse(abf, nil)
+
+	bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken(pkgsToken)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to parse apkbuild file")
+		return nil, fmt.Errorf("failed to init security provider: %w", err)
+	}
+
+	return repoApi.NewClientWithResponses(cfg.Server, repoApi.WithRequestEditorFn(bearerTokenProvider.Intercept))
+}
+
+// newAnonymousClient - create an API client without a token, for the endpoints that don't need one
+func newAnonymousClient() (*repoApi.ClientWithResponses, error) {
+	return repoApi.NewClientWithResponses(cfg.Server)
+}
+
+// page - one page of a listing, the parts of the generated ListX responses that allPages needs
+type page[T any] struct {
+	items  *[]T
+	status int
+	header http.Header
+	body   []byte
+}
+
+// allPages - follow the X-Next-Cursor header through every page of a listing, fetch is called with
+// the page size and the cursor for each page (nil for the first one)
+func allPages[T any](what string, fetch func(limit *int, cursor *string) (page[T], error)) ([]T, error) {
+	result := []T{}
+	limit := listPageSize
+	var cursor *string
+	for {
+		p, err := fetch(&limit, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if p.items == nil {
+			return nil, responseError(what, p.status, p.body)
+		}
+		result = append(result, *p.items...)
+
+		next := p.header.Get("X-Next-Cursor")
+		if next == "" {
+			return result, nil
+		}
+		cursor = &next
 	}
+}
+
+// listAllPackages - fetch every page of a repo's package listing
+func listAllPackages(ctx context.Context, client *repoApi.ClientWithResponses, org, distro, version, repo, arch string) ([]repoApi.Package, error) {
+	return allPages("list packages", func(limit *int, cursor *string) (page[repoApi.Package], error) {
+		resp, err := client.ListPackagesByRepoWithResponse(ctx, org, distro, version, repo, arch, &repoApi.ListPackagesByRepoParams{Limit: limit, Cursor: cursor})
+		if err != nil {
+			return page[repoApi.Package]{}, err
+		}
+		return page[repoApi.Package]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+	})
+}
 
-	apkFilename := fmt.Sprintf("%s-%s-r%s.apk", parsed.Pkgname, parsed.Pkgver, parsed.Pkgrel)
-	// log.Debug().Str("apkFilename", apkFilename).Msg("")
+// listAllRepos - fetch every page of a distro version's repo listing
+func listAllRepos(ctx context.Context, client *repoApi.ClientWithResponses, org, distro, version string) ([]repoApi.Repo, error) {
+	return allPages("list repos", func(limit *int, cursor *string) (page[repoApi.Repo], error) {
+		resp, err := client.ListReposWithResponse(ctx, org, distro, version, &repoApi.ListReposParams{Limit: limit, Cursor: cursor})
+		if err != nil {
+			return page[repoApi.Repo]{}, err
+		}
+		return page[repoApi.Repo]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+	})
+}
 
-	return apkFilename, nil
+// listAllArches - fetch every page of a repo's arch listing
+func listAllArches(ctx context.Context, client *repoApi.ClientWithResponses, org, distro, version, repo string) ([]repoApi.Architecture, error) {
+	return allPages("list arches", func(limit *int, cursor *string) (page[repoApi.Architecture], error) {
+		resp, err := client.ListArchesWithResponse(ctx, org, distro, version, repo, &repoApi.ListArchesParams{Limit: limit, Cursor: cursor})
+		if err != nil {
+			return page[repoApi.Architecture]{}, err
+		}
+		return page[repoApi.Architecture]{items: resp.JSON200, status: resp.StatusCode(), header: resp.HTTPResponse.Header, body: resp.Body}, nil
+	})
 }
@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -26,9 +27,104 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditEntryOutcome.
+const (
+	Failure AuditEntryOutcome = "failure"
+	Success AuditEntryOutcome = "success"
+)
+
+// Defines values for PackageFileType.
+const (
+	Device   PackageFileType = "device"
+	Dir      PackageFileType = "dir"
+	Fifo     PackageFileType = "fifo"
+	File     PackageFileType = "file"
+	Hardlink PackageFileType = "hardlink"
+	Symlink  PackageFileType = "symlink"
+)
+
+// Defines values for SigningKeyStatus.
+const (
+	Active   SigningKeyStatus = "active"
+	Inactive SigningKeyStatus = "inactive"
+	Retired  SigningKeyStatus = "retired"
+	Rotating SigningKeyStatus = "rotating"
+)
+
+// Defines values for ListAuditEntriesParamsFormat.
+const (
+	Json  ListAuditEntriesParamsFormat = "json"
+	Jsonl ListAuditEntriesParamsFormat = "jsonl"
+)
+
+// Defines values for SearchPackagesParamsSort.
+const (
+	SearchPackagesParamsSortBuildTime SearchPackagesParamsSort = "buildTime"
+	SearchPackagesParamsSortName      SearchPackagesParamsSort = "name"
+	SearchPackagesParamsSortRepo      SearchPackagesParamsSort = "repo"
+	SearchPackagesParamsSortVersion   SearchPackagesParamsSort = "version"
+)
+
+// Defines values for SearchPackagesParamsOrder.
+const (
+	Asc  SearchPackagesParamsOrder = "asc"
+	Desc SearchPackagesParamsOrder = "desc"
+)
+
 // Architecture defines model for Architecture.
 type Architecture = string
 
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Arch     *string      `json:"arch,omitempty"`
+	ClientIp string       `json:"clientIp"`
+	Distro   *string      `json:"distro,omitempty"`
+	Files    *[]AuditFile `json:"files,omitempty"`
+	Method   string       `json:"method"`
+
+	// Operation the operation id from this spec, e.g. UploadRepoFile
+	Operation string            `json:"operation"`
+	Org       string            `json:"org"`
+	Outcome   AuditEntryOutcome `json:"outcome"`
+	Path      string            `json:"path"`
+	Repo      *string           `json:"repo,omitempty"`
+
+	// Status the http status the request got
+	Status int       `json:"status"`
+	Time   time.Time `json:"time"`
+
+	// TokenId the start of the token's sha256, identifies it without giving it away
+	TokenId *string `json:"tokenId,omitempty"`
+
+	// TokenName the name of the token file the request authenticated with
+	TokenName *string `json:"tokenName,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// AuditEntryOutcome defines model for AuditEntry.Outcome.
+type AuditEntryOutcome string
+
+// AuditFile defines model for AuditFile.
+type AuditFile struct {
+	Arch   *string `json:"arch,omitempty"`
+	Name   string  `json:"name"`
+	Sha256 *string `json:"sha256,omitempty"`
+	Size   *int64  `json:"size,omitempty"`
+}
+
+// DependencyReport defines model for DependencyReport.
+type DependencyReport struct {
+	Arch      string             `json:"arch"`
+	Conflicts []ProviderConflict `json:"conflicts"`
+
+	// Cycles groups of packages that depend on each other
+	Cycles [][]string `json:"cycles"`
+
+	// Packages number of packages checked
+	Packages    int                     `json:"packages"`
+	Unsatisfied []UnsatisfiedDependency `json:"unsatisfied"`
+}
+
 // Distribution defines model for Distribution.
 type Distribution = string
 
@@ -38,12 +134,85 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// FinalizeUpload defines model for FinalizeUpload.
+type FinalizeUpload struct {
+	// Sha256 the sha256 of the whole package, hex encoded
+	Sha256 string `json:"sha256"`
+}
+
 // GenerateIndex defines model for GenerateIndex.
 type GenerateIndex struct {
 	// Status package index success status
 	Status bool `json:"status"`
 }
 
+// IndexedPackage defines model for IndexedPackage.
+type IndexedPackage struct {
+	Arch      string     `json:"arch"`
+	BuildTime *time.Time `json:"buildTime,omitempty"`
+
+	// Checksum the Q1 prefixed checksum from the index
+	Checksum      *string   `json:"checksum,omitempty"`
+	Depends       *[]string `json:"depends,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	Distro        string    `json:"distro"`
+	DistroVersion string    `json:"distroVersion"`
+
+	// Filename name of the .apk file in the repo
+	Filename      *string   `json:"filename,omitempty"`
+	InstallIf     *[]string `json:"installIf,omitempty"`
+	InstalledSize *int64    `json:"installedSize,omitempty"`
+	License       *string   `json:"license,omitempty"`
+	Maintainer    *string   `json:"maintainer,omitempty"`
+
+	// Name name of the package
+	Name     string    `json:"name"`
+	Origin   *string   `json:"origin,omitempty"`
+	Provides *[]string `json:"provides,omitempty"`
+	Repo     string    `json:"repo"`
+	Size     *int64    `json:"size,omitempty"`
+	Url      *string   `json:"url,omitempty"`
+	Version  string    `json:"version"`
+}
+
+// MirrorArchStatus defines model for MirrorArchStatus.
+type MirrorArchStatus struct {
+	Arch string `json:"arch"`
+
+	// Downloaded packages downloaded by the last successful sync
+	Downloaded *int `json:"downloaded,omitempty"`
+
+	// Error why the last sync failed, missing if it worked
+	Error       *string    `json:"error,omitempty"`
+	LastSuccess *time.Time `json:"lastSuccess,omitempty"`
+
+	// LastSync when the last sync finished, whether or not it worked
+	LastSync *time.Time `json:"lastSync,omitempty"`
+
+	// Packages packages in the upstream index at the last successful sync
+	Packages *int `json:"packages,omitempty"`
+
+	// Removed packages removed by the last successful sync
+	Removed *int `json:"removed,omitempty"`
+
+	// SignedBy the upstream key the index was signed with
+	SignedBy *string `json:"signedBy,omitempty"`
+}
+
+// MirrorStatus defines model for MirrorStatus.
+type MirrorStatus struct {
+	Arches []MirrorArchStatus `json:"arches"`
+
+	// Interval how often it's synced, as a Go duration (e.g. 1h0m0s)
+	Interval *string `json:"interval,omitempty"`
+
+	// Syncing whether a sync is running right now
+	Syncing bool `json:"syncing"`
+
+	// Upstream the upstream repo's URL, the arch is added to the end
+	Upstream string `json:"upstream"`
+}
+
 // NewRepo defines model for NewRepo.
 type NewRepo struct {
 	// Description Description of the repo to add - not functional - just for ease of use
@@ -53,6 +222,40 @@ type NewRepo struct {
 	Name string `json:"name"`
 }
 
+// NewSigningKey defines model for NewSigningKey.
+type NewSigningKey struct {
+	// Activate make the new key active straight away (the current active key moves to rotating)
+	Activate *bool `json:"activate,omitempty"`
+	Bits     *int  `json:"bits,omitempty"`
+}
+
+// NewSnapshot defines model for NewSnapshot.
+type NewSnapshot struct {
+	// Arches the arches to snapshot, all of them if this is missing
+	Arches      *[]string `json:"arches,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Name        string    `json:"name"`
+}
+
+// NewUploadSession defines model for NewUploadSession.
+type NewUploadSession struct {
+	// File the package's file name
+	File string `json:"file"`
+
+	// Size the package's size, if it's known up front
+	Size *int64 `json:"size,omitempty"`
+}
+
+// NoarchPackage defines model for NoarchPackage.
+type NoarchPackage struct {
+	// Arches the arches the package was added to
+	Arches []string `json:"arches"`
+
+	// Name name of the package
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
 // Organization defines model for Organization.
 type Organization struct {
 	// Distributions the list of repos that belong to this org (this data may be dependent on auth)
@@ -70,6 +273,47 @@ type Package struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// PackageDetail defines model for PackageDetail.
+type PackageDetail struct {
+	Files   []PackageFile  `json:"files"`
+	Package IndexedPackage `json:"package"`
+
+	// Pkginfo the raw .PKGINFO contents, keys can have multiple values (depend, provides, etc)
+	Pkginfo map[string][]string `json:"pkginfo"`
+
+	// Scripts install scripts in the package (.pre-install, .post-upgrade, etc)
+	Scripts []string `json:"scripts"`
+
+	// SignatureKey name of the key the package was signed with, missing if the package isn't signed
+	SignatureKey *string `json:"signatureKey,omitempty"`
+}
+
+// PackageFile defines model for PackageFile.
+type PackageFile struct {
+	// LinkTarget target of symlinks and hardlinks
+	LinkTarget *string `json:"linkTarget,omitempty"`
+
+	// Mode octal permission bits (e.g. 0755)
+	Mode string `json:"mode"`
+
+	// Name path of the file in the package
+	Name string          `json:"name"`
+	Size int64           `json:"size"`
+	Type PackageFileType `json:"type"`
+}
+
+// PackageFileType defines model for PackageFile.Type.
+type PackageFileType string
+
+// ProviderConflict defines model for ProviderConflict.
+type ProviderConflict struct {
+	// Name the package name or provides entry
+	Name string `json:"name"`
+
+	// Providers the packages that all provide name
+	Providers []string `json:"providers"`
+}
+
 // Repo defines model for Repo.
 type Repo struct {
 	// Architectures list of architectures in this repo
@@ -85,17 +329,359 @@ type Repo struct {
 // RepoVersion defines model for RepoVersion.
 type RepoVersion = string
 
+// ReverseDependency defines model for ReverseDependency.
+type ReverseDependency struct {
+	Arch string `json:"arch"`
+
+	// Dependency the depends entry that led here
+	Dependency string `json:"dependency"`
+
+	// Depth 1 for direct dependents, 2 for their dependents, etc
+	Depth         int    `json:"depth"`
+	Distro        string `json:"distro"`
+	DistroVersion string `json:"distroVersion"`
+	Name          string `json:"name"`
+	Repo          string `json:"repo"`
+	Version       string `json:"version"`
+
+	// Via the package that satisfies the dependency
+	Via string `json:"via"`
+}
+
+// SearchResults defines model for SearchResults.
+type SearchResults struct {
+	Packages []IndexedPackage `json:"packages"`
+
+	// Total number of packages that matched before limit/offset were applied
+	Total int `json:"total"`
+}
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	Bits    *int       `json:"bits,omitempty"`
+	Created *time.Time `json:"created,omitempty"`
+
+	// Fingerprint sha256 of the DER encoded public key
+	Fingerprint *string `json:"fingerprint,omitempty"`
+
+	// Name the name of the key, the private key is <name>.rsa
+	Name string `json:"name"`
+
+	// PublicKey the filename of the published public key (<name>.rsa.pub), clients put this in /etc/apk/keys
+	PublicKey string `json:"publicKey"`
+
+	// Status active - signs indexes, there is at most one
+	// rotating - also signs indexes, e.g. the previous key during a rotation
+	// inactive - published, but doesn't sign anything yet
+	// retired - doesn't sign anything any more, but stays published so existing signatures verify
+	Status SigningKeyStatus `json:"status"`
+
+	// ValidFrom when the key was first made active, missing if it's never signed anything
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil when the key was retired, missing if it hasn't been
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+// SigningKeyStatus active - signs indexes, there is at most one
+// rotating - also signs indexes, e.g. the previous key during a rotation
+// inactive - published, but doesn't sign anything yet
+// retired - doesn't sign anything any more, but stays published so existing signatures verify
+type SigningKeyStatus string
+
+// SigningKeyUpdate defines model for SigningKeyUpdate.
+type SigningKeyUpdate struct {
+	// Status active - signs indexes, there is at most one
+	// rotating - also signs indexes, e.g. the previous key during a rotation
+	// inactive - published, but doesn't sign anything yet
+	// retired - doesn't sign anything any more, but stays published so existing signatures verify
+	Status SigningKeyStatus `json:"status"`
+}
+
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	Arches      []SnapshotArch `json:"arches"`
+	Created     time.Time      `json:"created"`
+	Description *string        `json:"description,omitempty"`
+	Name        string         `json:"name"`
+}
+
+// SnapshotArch defines model for SnapshotArch.
+type SnapshotArch struct {
+	Arch         string `json:"arch"`
+	PackageCount int    `json:"packageCount"`
+
+	// Packages the packages in the snapshot, left out of lists
+	Packages *[]SnapshotPackage `json:"packages,omitempty"`
+}
+
+// SnapshotPackage defines model for SnapshotPackage.
+type SnapshotPackage struct {
+	File   string `json:"file"`
+	Sha256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// Expires when the transaction is aborted if nothing else happens to it
+	Expires time.Time         `json:"expires"`
+	Files   []TransactionFile `json:"files"`
+	Id      string            `json:"id"`
+}
+
+// TransactionFile defines model for TransactionFile.
+type TransactionFile struct {
+	Arch string `json:"arch"`
+	File string `json:"file"`
+
+	// Name the package's name
+	Name    string `json:"name"`
+	Sha256  string `json:"sha256"`
+	Size    int64  `json:"size"`
+	Version string `json:"version"`
+}
+
+// UnsatisfiedDependency defines model for UnsatisfiedDependency.
+type UnsatisfiedDependency struct {
+	// Dependency the depends entry that nothing provides
+	Dependency string `json:"dependency"`
+	Package    string `json:"package"`
+	Version    string `json:"version"`
+}
+
+// UploadSession defines model for UploadSession.
+type UploadSession struct {
+	// Expires when the session is removed if no more chunks arrive
+	Expires time.Time `json:"expires"`
+	File    string    `json:"file"`
+	Id      string    `json:"id"`
+
+	// Offset how much has arrived, the next chunk starts here
+	Offset int64  `json:"offset"`
+	Size   *int64 `json:"size,omitempty"`
+}
+
+// Cursor defines model for cursor.
+type Cursor = string
+
+// IfNoneMatch defines model for ifNoneMatch.
+type IfNoneMatch = string
+
+// Limit defines model for limit.
+type Limit = int
+
+// ListOrganizationsParams defines parameters for ListOrganizations.
+type ListOrganizationsParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// Since only entries at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until only entries before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Operation only entries for this operation id (e.g. UploadRepoFile)
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// Format json returns an array, jsonl streams one entry per line for exporting
+	Format *ListAuditEntriesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListAuditEntriesParamsFormat defines parameters for ListAuditEntries.
+type ListAuditEntriesParamsFormat string
+
+// ListDistrosParams defines parameters for ListDistros.
+type ListDistrosParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListReverseDependenciesParams defines parameters for ListReverseDependencies.
+type ListReverseDependenciesParams struct {
+	// Name package name or provides entry (e.g. so:libfoo.so.1)
+	Name string `form:"name" json:"name"`
+
+	// Distro only look in this distribution
+	Distro *string `form:"distro,omitempty" json:"distro,omitempty"`
+
+	// DistroVersion only look in this version of the distribution
+	DistroVersion *string `form:"distroVersion,omitempty" json:"distroVersion,omitempty"`
+
+	// Depth how many levels of dependents to follow, 1 only returns direct dependents
+	Depth *int `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// SearchPackagesParams defines parameters for SearchPackages.
+type SearchPackagesParams struct {
+	// Distro only search this distribution
+	Distro *string `form:"distro,omitempty" json:"distro,omitempty"`
+
+	// DistroVersion only search this version of the distribution
+	DistroVersion *string `form:"distroVersion,omitempty" json:"distroVersion,omitempty"`
+
+	// Repo only search this repo
+	Repo *string `form:"repo,omitempty" json:"repo,omitempty"`
+
+	// Arch only search this architecture
+	Arch *string `form:"arch,omitempty" json:"arch,omitempty"`
+
+	// Name package name glob (e.g. py3-*)
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Provides provides glob (e.g. so:libfoo.so.1 or cmd:foo)
+	Provides *string `form:"provides,omitempty" json:"provides,omitempty"`
+
+	// Origin origin (source package) name glob
+	Origin *string `form:"origin,omitempty" json:"origin,omitempty"`
+
+	// Maintainer case insensitive substring of the maintainer
+	Maintainer *string `form:"maintainer,omitempty" json:"maintainer,omitempty"`
+
+	// VersionRange space separated list of version constraints that all have to match (e.g. ">=1.2 <2")
+	VersionRange *string `form:"versionRange,omitempty" json:"versionRange,omitempty"`
+
+	// Sort field to sort the results by
+	Sort *SearchPackagesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *SearchPackagesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset number of results to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SearchPackagesParamsSort defines parameters for SearchPackages.
+type SearchPackagesParamsSort string
+
+// SearchPackagesParamsOrder defines parameters for SearchPackages.
+type SearchPackagesParamsOrder string
+
+// ListVersionsParams defines parameters for ListVersions.
+type ListVersionsParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListReposParams defines parameters for ListRepos.
+type ListReposParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListArchesParams defines parameters for ListArches.
+type ListArchesParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// CheckRepoDependenciesParams defines parameters for CheckRepoDependencies.
+type CheckRepoDependenciesParams struct {
+	// Arch only check this architecture
+	Arch *string `form:"arch,omitempty" json:"arch,omitempty"`
+}
+
+// SyncMirrorParams defines parameters for SyncMirror.
+type SyncMirrorParams struct {
+	// Wait wait for the sync to finish instead of doing it in the background
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// CreateNoarchPackageMultipartBody defines parameters for CreateNoarchPackage.
+type CreateNoarchPackageMultipartBody struct {
+	Package *openapi_types.File `json:"package,omitempty"`
+}
+
+// CreatePackageIndexParams defines parameters for CreatePackageIndex.
+type CreatePackageIndexParams struct {
+	// Wait wait for the index to be generated instead of doing it in the background
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// ListPackagesByRepoParams defines parameters for ListPackagesByRepo.
+type ListPackagesByRepoParams struct {
+	// Limit maximum number of entries to return, everything is returned when this isn't set
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor the X-Next-Cursor header from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IfNoneMatch ETag from a previous response, a 304 is returned if the listing hasn't changed
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
 // CreatePackageMultipartBody defines parameters for CreatePackage.
 type CreatePackageMultipartBody struct {
 	Package *openapi_types.File `json:"package,omitempty"`
 }
 
+// UploadChunkParams defines parameters for UploadChunk.
+type UploadChunkParams struct {
+	// Offset where in the package this chunk starts
+	Offset int64 `form:"offset" json:"offset"`
+}
+
 // CreateRepoJSONRequestBody defines body for CreateRepo for application/json ContentType.
 type CreateRepoJSONRequestBody = NewRepo
 
+// CreateSigningKeyJSONRequestBody defines body for CreateSigningKey for application/json ContentType.
+type CreateSigningKeyJSONRequestBody = NewSigningKey
+
+// UpdateSigningKeyJSONRequestBody defines body for UpdateSigningKey for application/json ContentType.
+type UpdateSigningKeyJSONRequestBody = SigningKeyUpdate
+
+// CreateNoarchPackageMultipartRequestBody defines body for CreateNoarchPackage for multipart/form-data ContentType.
+type CreateNoarchPackageMultipartRequestBody CreateNoarchPackageMultipartBody
+
+// CreateSnapshotJSONRequestBody defines body for CreateSnapshot for application/json ContentType.
+type CreateSnapshotJSONRequestBody = NewSnapshot
+
 // CreatePackageMultipartRequestBody defines body for CreatePackage for multipart/form-data ContentType.
 type CreatePackageMultipartRequestBody CreatePackageMultipartBody
 
+// CreateUploadSessionJSONRequestBody defines body for CreateUploadSession for application/json ContentType.
+type CreateUploadSessionJSONRequestBody = NewUploadSession
+
+// FinalizeUploadJSONRequestBody defines body for FinalizeUpload for application/json ContentType.
+type FinalizeUploadJSONRequestBody = FinalizeUpload
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -179,7 +765,7 @@ type ClientInterface interface {
 	HeadHealthReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListOrganizations request
-	ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListOrganizations(ctx context.Context, params *ListOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganization request
 	GetOrganization(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -189,32 +775,141 @@ type ClientInterface interface {
 
 	CreateRepo(ctx context.Context, org string, body CreateRepoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAuditEntries request
+	ListAuditEntries(ctx context.Context, org string, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDistros request
-	ListDistros(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListDistros(ctx context.Context, org string, params *ListDistrosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReverseDependencies request
+	ListReverseDependencies(ctx context.Context, org string, params *ListReverseDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchPackages request
+	SearchPackages(ctx context.Context, org string, params *SearchPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrgDistro request
 	GetOrgDistro(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetKeyring request
+	GetKeyring(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RebuildKeyring request
+	RebuildKeyring(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSigningKeys request
+	ListSigningKeys(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSigningKeyWithBody request with any body
+	CreateSigningKeyWithBody(ctx context.Context, org string, distro string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSigningKey(ctx context.Context, org string, distro string, body CreateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSigningKey request
+	GetSigningKey(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSigningKeyWithBody request with any body
+	UpdateSigningKeyWithBody(ctx context.Context, org string, distro string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSigningKey(ctx context.Context, org string, distro string, name string, body UpdateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSigningKeyPublicKey request
+	GetSigningKeyPublicKey(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListVersions request
-	ListVersions(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListVersions(ctx context.Context, org string, distro string, params *ListVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListRepos request
-	ListRepos(ctx context.Context, org string, distro string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListRepos(ctx context.Context, org string, distro string, version string, params *ListReposParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// FindRepoByName request
 	FindRepoByName(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListArches request
-	ListArches(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListArches(ctx context.Context, org string, distro string, version string, repo string, params *ListArchesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckRepoDependencies request
+	CheckRepoDependencies(ctx context.Context, org string, distro string, version string, repo string, params *CheckRepoDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMirrorStatus request
+	GetMirrorStatus(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncMirror request
+	SyncMirror(ctx context.Context, org string, distro string, version string, repo string, params *SyncMirrorParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateNoarchPackageWithBody request with any body
+	CreateNoarchPackageWithBody(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSnapshots request
+	ListSnapshots(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSnapshotWithBody request with any body
+	CreateSnapshotWithBody(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSnapshot(ctx context.Context, org string, distro string, version string, repo string, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSnapshot request
+	DeleteSnapshot(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSnapshot request
+	GetSnapshot(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSnapshotFile request
+	GetSnapshotFile(ctx context.Context, org string, distro string, version string, repo string, snapshot string, arch string, file string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransaction request
+	CreateTransaction(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AbortTransaction request
+	AbortTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransaction request
+	GetTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CommitTransaction request
+	CommitTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadTransactionFileWithBody request with any body
+	UploadTransactionFileWithBody(ctx context.Context, org string, distro string, version string, repo string, id string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreatePackageIndex request
-	CreatePackageIndex(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreatePackageIndex(ctx context.Context, org string, distro string, version string, repo string, arch string, params *CreatePackageIndexParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListPackagesByRepo request
-	ListPackagesByRepo(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListPackagesByRepo(ctx context.Context, org string, distro string, version string, repo string, arch string, params *ListPackagesByRepoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreatePackageWithBody request with any body
 	CreatePackageWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPackage request
+	GetPackage(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPackageVersion request
+	GetPackageVersion(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, pkgVersion string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUploadSessionWithBody request with any body
+	CreateUploadSessionWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, body CreateUploadSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUploadSession request
+	DeleteUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUploadSession request
+	GetUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadChunkWithBody request with any body
+	UploadChunkWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinalizeUploadWithBody request with any body
+	FinalizeUploadWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FinalizeUpload(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, body FinalizeUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRepoFile request
+	GetRepoFile(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadRepoFileWithBody request with any body
+	UploadRepoFileWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetHealthPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -253,8 +948,8 @@ func (c *Client) HeadHealthReady(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationsRequest(c.Server)
+func (c *Client) ListOrganizations(ctx context.Context, params *ListOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -301,8 +996,8 @@ func (c *Client) CreateRepo(ctx context.Context, org string, body CreateRepoJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDistros(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDistrosRequest(c.Server, org)
+func (c *Client) ListAuditEntries(ctx context.Context, org string, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEntriesRequest(c.Server, org, params)
 	if err != nil {
 		return nil, err
 	}
@@ -313,8 +1008,8 @@ func (c *Client) ListDistros(ctx context.Context, org string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrgDistro(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrgDistroRequest(c.Server, org, distro)
+func (c *Client) ListDistros(ctx context.Context, org string, params *ListDistrosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDistrosRequest(c.Server, org, params)
 	if err != nil {
 		return nil, err
 	}
@@ -325,8 +1020,8 @@ func (c *Client) GetOrgDistro(ctx context.Context, org string, distro string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListVersions(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListVersionsRequest(c.Server, org, distro)
+func (c *Client) ListReverseDependencies(ctx context.Context, org string, params *ListReverseDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReverseDependenciesRequest(c.Server, org, params)
 	if err != nil {
 		return nil, err
 	}
@@ -337,8 +1032,8 @@ func (c *Client) ListVersions(ctx context.Context, org string, distro string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRepos(ctx context.Context, org string, distro string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListReposRequest(c.Server, org, distro, version)
+func (c *Client) SearchPackages(ctx context.Context, org string, params *SearchPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchPackagesRequest(c.Server, org, params)
 	if err != nil {
 		return nil, err
 	}
@@ -349,8 +1044,8 @@ func (c *Client) ListRepos(ctx context.Context, org string, distro string, versi
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindRepoByName(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindRepoByNameRequest(c.Server, org, distro, version, repo)
+func (c *Client) GetOrgDistro(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgDistroRequest(c.Server, org, distro)
 	if err != nil {
 		return nil, err
 	}
@@ -361,8 +1056,8 @@ func (c *Client) FindRepoByName(ctx context.Context, org string, distro string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListArches(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListArchesRequest(c.Server, org, distro, version, repo)
+func (c *Client) GetKeyring(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKeyringRequest(c.Server, org, distro)
 	if err != nil {
 		return nil, err
 	}
@@ -373,8 +1068,8 @@ func (c *Client) ListArches(ctx context.Context, org string, distro string, vers
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePackageIndex(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePackageIndexRequest(c.Server, org, distro, version, repo, arch)
+func (c *Client) RebuildKeyring(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRebuildKeyringRequest(c.Server, org, distro)
 	if err != nil {
 		return nil, err
 	}
@@ -385,8 +1080,8 @@ func (c *Client) CreatePackageIndex(ctx context.Context, org string, distro stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPackagesByRepo(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPackagesByRepoRequest(c.Server, org, distro, version, repo, arch)
+func (c *Client) ListSigningKeys(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSigningKeysRequest(c.Server, org, distro)
 	if err != nil {
 		return nil, err
 	}
@@ -397,8 +1092,8 @@ func (c *Client) ListPackagesByRepo(ctx context.Context, org string, distro stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePackageWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePackageRequestWithBody(c.Server, org, distro, version, repo, arch, contentType, body)
+func (c *Client) CreateSigningKeyWithBody(ctx context.Context, org string, distro string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSigningKeyRequestWithBody(c.Server, org, distro, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -409,452 +1104,472 @@ func (c *Client) CreatePackageWithBody(ctx context.Context, org string, distro s
 	return c.Client.Do(req)
 }
 
-// NewGetHealthPingRequest generates requests for GetHealthPing
-func NewGetHealthPingRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSigningKey(ctx context.Context, org string, distro string, body CreateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSigningKeyRequest(c.Server, org, distro, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health/ping")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSigningKey(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSigningKeyRequest(c.Server, org, distro, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetHealthReadyRequest generates requests for GetHealthReady
-func NewGetHealthReadyRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSigningKeyWithBody(ctx context.Context, org string, distro string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSigningKeyRequestWithBody(c.Server, org, distro, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSigningKey(ctx context.Context, org string, distro string, name string, body UpdateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSigningKeyRequest(c.Server, org, distro, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewHeadHealthReadyRequest generates requests for HeadHealthReady
-func NewHeadHealthReadyRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSigningKeyPublicKey(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSigningKeyPublicKeyRequest(c.Server, org, distro, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListVersions(ctx context.Context, org string, distro string, params *ListVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVersionsRequest(c.Server, org, distro, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationsRequest generates requests for ListOrganizations
-func NewListOrganizationsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListRepos(ctx context.Context, org string, distro string, version string, params *ListReposParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReposRequest(c.Server, org, distro, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/orgs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindRepoByName(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindRepoByNameRequest(c.Server, org, distro, version, repo)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetOrganizationRequest generates requests for GetOrganization
-func NewGetOrganizationRequest(server string, org string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) ListArches(ctx context.Context, org string, distro string, version string, repo string, params *ListArchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListArchesRequest(c.Server, org, distro, version, repo, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CheckRepoDependencies(ctx context.Context, org string, distro string, version string, repo string, params *CheckRepoDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckRepoDependenciesRequest(c.Server, org, distro, version, repo, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateRepoRequest calls the generic CreateRepo builder with application/json body
-func NewCreateRepoRequest(server string, org string, body CreateRepoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMirrorStatus(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMirrorStatusRequest(c.Server, org, distro, version, repo)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRepoRequestWithBody(server, org, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateRepoRequestWithBody generates requests for CreateRepo with any type of body
-func NewCreateRepoRequestWithBody(server string, org string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) SyncMirror(ctx context.Context, org string, distro string, version string, repo string, params *SyncMirrorParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncMirrorRequest(c.Server, org, distro, version, repo, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateNoarchPackageWithBody(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNoarchPackageRequestWithBody(c.Server, org, distro, version, repo, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSnapshots(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSnapshotsRequest(c.Server, org, distro, version, repo)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDistrosRequest generates requests for ListDistros
-func NewListDistrosRequest(server string, org string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) CreateSnapshotWithBody(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSnapshotRequestWithBody(c.Server, org, distro, version, repo, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSnapshot(ctx context.Context, org string, distro string, version string, repo string, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSnapshotRequest(c.Server, org, distro, version, repo, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s/distros", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSnapshot(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSnapshotRequest(c.Server, org, distro, version, repo, snapshot)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetOrgDistroRequest generates requests for GetOrgDistro
-func NewGetOrgDistroRequest(server string, org string, distro string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) GetSnapshot(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSnapshotRequest(c.Server, org, distro, version, repo, snapshot)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSnapshotFile(ctx context.Context, org string, distro string, version string, repo string, snapshot string, arch string, file string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSnapshotFileRequest(c.Server, org, distro, version, repo, snapshot, arch, file)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTransaction(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionRequest(c.Server, org, distro, version, repo)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListVersionsRequest generates requests for ListVersions
-func NewListVersionsRequest(server string, org string, distro string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) AbortTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortTransactionRequest(c.Server, org, distro, version, repo, id)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionRequest(c.Server, org, distro, version, repo, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s/%s/versions", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CommitTransaction(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitTransactionRequest(c.Server, org, distro, version, repo, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListReposRequest generates requests for ListRepos
-func NewListReposRequest(server string, org string, distro string, version string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) UploadTransactionFileWithBody(ctx context.Context, org string, distro string, version string, repo string, id string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadTransactionFileRequestWithBody(c.Server, org, distro, version, repo, id, arch, file, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+func (c *Client) CreatePackageIndex(ctx context.Context, org string, distro string, version string, repo string, arch string, params *CreatePackageIndexParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageIndexRequest(c.Server, org, distro, version, repo, arch, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListPackagesByRepo(ctx context.Context, org string, distro string, version string, repo string, arch string, params *ListPackagesByRepoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPackagesByRepoRequest(c.Server, org, distro, version, repo, arch, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s/%s/%s/repos", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePackageWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageRequestWithBody(c.Server, org, distro, version, repo, arch, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindRepoByNameRequest generates requests for FindRepoByName
-func NewFindRepoByNameRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) GetPackage(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackageRequest(c.Server, org, distro, version, repo, arch, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+func (c *Client) GetPackageVersion(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, pkgVersion string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackageVersionRequest(c.Server, org, distro, version, repo, arch, name, pkgVersion)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+func (c *Client) CreateUploadSessionWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadSessionRequestWithBody(c.Server, org, distro, version, repo, arch, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+func (c *Client) CreateUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, body CreateUploadSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadSessionRequest(c.Server, org, distro, version, repo, arch, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUploadSessionRequest(c.Server, org, distro, version, repo, arch, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUploadSession(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadSessionRequest(c.Server, org, distro, version, repo, arch, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UploadChunkWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadChunkRequestWithBody(c.Server, org, distro, version, repo, arch, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListArchesRequest generates requests for ListArches
-func NewListArchesRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+func (c *Client) FinalizeUploadWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinalizeUploadRequestWithBody(c.Server, org, distro, version, repo, arch, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+func (c *Client) FinalizeUpload(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, body FinalizeUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinalizeUploadRequest(c.Server, org, distro, version, repo, arch, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+func (c *Client) GetRepoFile(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRepoFileRequest(c.Server, org, distro, version, repo, arch, file)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+func (c *Client) UploadRepoFileWithBody(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadRepoFileRequestWithBody(c.Server, org, distro, version, repo, arch, file, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetHealthPingRequest generates requests for GetHealthPing
+func NewGetHealthPingRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/%s/%s/%s/%s/architectures", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/health/ping")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -872,51 +1587,43 @@ func NewListArchesRequest(server string, org string, distro string, version stri
 	return req, nil
 }
 
-// NewCreatePackageIndexRequest generates requests for CreatePackageIndex
-func NewCreatePackageIndexRequest(server string, org string, distro string, version string, repo string, arch string) (*http.Request, error) {
+// NewGetHealthReadyRequest generates requests for GetHealthReady
+func NewGetHealthReadyRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/health/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam4 string
+	return req, nil
+}
 
-	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
-	if err != nil {
-		return nil, err
-	}
+// NewHeadHealthReadyRequest generates requests for HeadHealthReady
+func NewHeadHealthReadyRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/index", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	operationPath := fmt.Sprintf("/health/ready")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -926,7 +1633,7 @@ func NewCreatePackageIndexRequest(server string, org string, distro string, vers
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -934,41 +1641,93 @@ func NewCreatePackageIndexRequest(server string, org string, distro string, vers
 	return req, nil
 }
 
-// NewListPackagesByRepoRequest generates requests for ListPackagesByRepo
-func NewListPackagesByRepoRequest(server string, org string, distro string, version string, repo string, arch string) (*http.Request, error) {
+// NewListOrganizationsRequest generates requests for ListOrganizations
+func NewListOrganizationsRequest(server string, params *ListOrganizationsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/orgs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
 
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam4 string
+	return req, nil
+}
 
-	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+// NewGetOrganizationRequest generates requests for GetOrganization
+func NewGetOrganizationRequest(server string, org string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
 	if err != nil {
 		return nil, err
 	}
@@ -978,7 +1737,7 @@ func NewListPackagesByRepoRequest(server string, org string, distro string, vers
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	operationPath := fmt.Sprintf("/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -996,8 +1755,19 @@ func NewListPackagesByRepoRequest(server string, org string, distro string, vers
 	return req, nil
 }
 
-// NewCreatePackageRequestWithBody generates requests for CreatePackage with any type of body
-func NewCreatePackageRequestWithBody(server string, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateRepoRequest calls the generic CreateRepo builder with application/json body
+func NewCreateRepoRequest(server string, org string, body CreateRepoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRepoRequestWithBody(server, org, "application/json", bodyReader)
+}
+
+// NewCreateRepoRequestWithBody generates requests for CreateRepo with any type of body
+func NewCreateRepoRequestWithBody(server string, org string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1007,30 +1777,38 @@ func NewCreatePackageRequestWithBody(server string, org string, distro string, v
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	operationPath := fmt.Sprintf("/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam4 string
+	req.Header.Add("Content-Type", contentType)
 
-	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	return req, nil
+}
+
+// NewListAuditEntriesRequest generates requests for ListAuditEntries
+func NewListAuditEntriesRequest(server string, org string, params *ListAuditEntriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
 	if err != nil {
 		return nil, err
 	}
@@ -1040,7 +1818,7 @@ func NewCreatePackageRequestWithBody(server string, org string, distro string, v
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	operationPath := fmt.Sprintf("/%s/audit", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1050,1117 +1828,8593 @@ func NewCreatePackageRequestWithBody(server string, org string, distro string, v
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Since != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Operation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewListDistrosRequest generates requests for ListDistros
+func NewListDistrosRequest(server string, org string, params *ListDistrosParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetHealthPingWithResponse request
-	GetHealthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthPingResponse, error)
+	operationPath := fmt.Sprintf("/%s/distros", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetHealthReadyWithResponse request
-	GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// HeadHealthReadyWithResponse request
-	HeadHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeadHealthReadyResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ListOrganizationsWithResponse request
-	ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error)
+		if params.Limit != nil {
 
-	// GetOrganizationWithResponse request
-	GetOrganizationWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateRepoWithBodyWithResponse request with any body
-	CreateRepoWithBodyWithResponse(ctx context.Context, org string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error)
+		}
 
-	CreateRepoWithResponse(ctx context.Context, org string, body CreateRepoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error)
+		if params.Cursor != nil {
 
-	// ListDistrosWithResponse request
-	ListDistrosWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*ListDistrosResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetOrgDistroWithResponse request
-	GetOrgDistroWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetOrgDistroResponse, error)
+		}
 
-	// ListVersionsWithResponse request
-	ListVersionsWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*ListVersionsResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// ListReposWithResponse request
-	ListReposWithResponse(ctx context.Context, org string, distro string, version string, reqEditors ...RequestEditorFn) (*ListReposResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindRepoByNameWithResponse request
-	FindRepoByNameWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*FindRepoByNameResponse, error)
+	if params != nil {
 
-	// ListArchesWithResponse request
-	ListArchesWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*ListArchesResponse, error)
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
 
-	// CreatePackageIndexWithResponse request
-	CreatePackageIndexWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*CreatePackageIndexResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	// ListPackagesByRepoWithResponse request
-	ListPackagesByRepoWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*ListPackagesByRepoResponse, error)
+			req.Header.Set("If-None-Match", headerParam0)
+		}
 
-	// CreatePackageWithBodyWithResponse request with any body
-	CreatePackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageResponse, error)
-}
+	}
 
-type GetHealthPingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHealthPingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListReverseDependenciesRequest generates requests for ListReverseDependencies
+func NewListReverseDependenciesRequest(server string, org string, params *ListReverseDependenciesParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthPingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthReadyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetHealthReadyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/%s/rdepends", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthReadyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type HeadHealthReadyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r HeadHealthReadyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, params.Name); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HeadHealthReadyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.Distro != nil {
 
-type ListOrganizationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Organization
-	JSONDefault  *Error
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distro", runtime.ParamLocationQuery, *params.Distro); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.DistroVersion != nil {
 
-type GetOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distroVersion", runtime.ParamLocationQuery, *params.DistroVersion); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.Depth != nil {
 
-type CreateRepoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Repo
-	JSONDefault  *Error
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "depth", runtime.ParamLocationQuery, *params.Depth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r CreateRepoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRepoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDistrosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Distribution
-	JSONDefault  *Error
+	return req, nil
+}
+
+// NewSearchPackagesRequest generates requests for SearchPackages
+func NewSearchPackagesRequest(server string, org string, params *SearchPackagesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/search", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Distro != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distro", runtime.ParamLocationQuery, *params.Distro); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DistroVersion != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distroVersion", runtime.ParamLocationQuery, *params.DistroVersion); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Repo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repo", runtime.ParamLocationQuery, *params.Repo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Arch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "arch", runtime.ParamLocationQuery, *params.Arch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Provides != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provides", runtime.ParamLocationQuery, *params.Provides); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Origin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Maintainer != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maintainer", runtime.ParamLocationQuery, *params.Maintainer); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.VersionRange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "versionRange", runtime.ParamLocationQuery, *params.VersionRange); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgDistroRequest generates requests for GetOrgDistro
+func NewGetOrgDistroRequest(server string, org string, distro string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKeyringRequest generates requests for GetKeyring
+func NewGetKeyringRequest(server string, org string, distro string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keyring", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRebuildKeyringRequest generates requests for RebuildKeyring
+func NewRebuildKeyringRequest(server string, org string, distro string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keyring", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSigningKeysRequest generates requests for ListSigningKeys
+func NewListSigningKeysRequest(server string, org string, distro string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keys", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSigningKeyRequest calls the generic CreateSigningKey builder with application/json body
+func NewCreateSigningKeyRequest(server string, org string, distro string, body CreateSigningKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSigningKeyRequestWithBody(server, org, distro, "application/json", bodyReader)
+}
+
+// NewCreateSigningKeyRequestWithBody generates requests for CreateSigningKey with any type of body
+func NewCreateSigningKeyRequestWithBody(server string, org string, distro string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keys", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSigningKeyRequest generates requests for GetSigningKey
+func NewGetSigningKeyRequest(server string, org string, distro string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keys/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSigningKeyRequest calls the generic UpdateSigningKey builder with application/json body
+func NewUpdateSigningKeyRequest(server string, org string, distro string, name string, body UpdateSigningKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSigningKeyRequestWithBody(server, org, distro, name, "application/json", bodyReader)
+}
+
+// NewUpdateSigningKeyRequestWithBody generates requests for UpdateSigningKey with any type of body
+func NewUpdateSigningKeyRequestWithBody(server string, org string, distro string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keys/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSigningKeyPublicKeyRequest generates requests for GetSigningKeyPublicKey
+func NewGetSigningKeyPublicKeyRequest(server string, org string, distro string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/keys/%s/pub", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVersionsRequest generates requests for ListVersions
+func NewListVersionsRequest(server string, org string, distro string, params *ListVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListReposRequest generates requests for ListRepos
+func NewListReposRequest(server string, org string, distro string, version string, params *ListReposParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/repos", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewFindRepoByNameRequest generates requests for FindRepoByName
+func NewFindRepoByNameRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListArchesRequest generates requests for ListArches
+func NewListArchesRequest(server string, org string, distro string, version string, repo string, params *ListArchesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/architectures", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCheckRepoDependenciesRequest generates requests for CheckRepoDependencies
+func NewCheckRepoDependenciesRequest(server string, org string, distro string, version string, repo string, params *CheckRepoDependenciesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/depcheck", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Arch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "arch", runtime.ParamLocationQuery, *params.Arch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMirrorStatusRequest generates requests for GetMirrorStatus
+func NewGetMirrorStatusRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/mirror", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSyncMirrorRequest generates requests for SyncMirror
+func NewSyncMirrorRequest(server string, org string, distro string, version string, repo string, params *SyncMirrorParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/mirror/sync", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateNoarchPackageRequestWithBody generates requests for CreateNoarchPackage with any type of body
+func NewCreateNoarchPackageRequestWithBody(server string, org string, distro string, version string, repo string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/pkgs", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSnapshotsRequest generates requests for ListSnapshots
+func NewListSnapshotsRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/snapshots", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSnapshotRequest calls the generic CreateSnapshot builder with application/json body
+func NewCreateSnapshotRequest(server string, org string, distro string, version string, repo string, body CreateSnapshotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSnapshotRequestWithBody(server, org, distro, version, repo, "application/json", bodyReader)
+}
+
+// NewCreateSnapshotRequestWithBody generates requests for CreateSnapshot with any type of body
+func NewCreateSnapshotRequestWithBody(server string, org string, distro string, version string, repo string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/snapshots", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSnapshotRequest generates requests for DeleteSnapshot
+func NewDeleteSnapshotRequest(server string, org string, distro string, version string, repo string, snapshot string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "snapshot", runtime.ParamLocationPath, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/snapshots/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSnapshotRequest generates requests for GetSnapshot
+func NewGetSnapshotRequest(server string, org string, distro string, version string, repo string, snapshot string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "snapshot", runtime.ParamLocationPath, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/snapshots/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSnapshotFileRequest generates requests for GetSnapshotFile
+func NewGetSnapshotFileRequest(server string, org string, distro string, version string, repo string, snapshot string, arch string, file string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "snapshot", runtime.ParamLocationPath, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam6 string
+
+	pathParam6, err = runtime.StyleParamWithLocation("simple", false, "file", runtime.ParamLocationPath, file)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/snapshots/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5, pathParam6)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransactionRequest generates requests for CreateTransaction
+func NewCreateTransactionRequest(server string, org string, distro string, version string, repo string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/transactions", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAbortTransactionRequest generates requests for AbortTransaction
+func NewAbortTransactionRequest(server string, org string, distro string, version string, repo string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/transactions/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionRequest generates requests for GetTransaction
+func NewGetTransactionRequest(server string, org string, distro string, version string, repo string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/transactions/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCommitTransactionRequest generates requests for CommitTransaction
+func NewCommitTransactionRequest(server string, org string, distro string, version string, repo string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/transactions/%s/commit", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadTransactionFileRequestWithBody generates requests for UploadTransactionFile with any type of body
+func NewUploadTransactionFileRequestWithBody(server string, org string, distro string, version string, repo string, id string, arch string, file string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam6 string
+
+	pathParam6, err = runtime.StyleParamWithLocation("simple", false, "file", runtime.ParamLocationPath, file)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/transactions/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5, pathParam6)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePackageIndexRequest generates requests for CreatePackageIndex
+func NewCreatePackageIndexRequest(server string, org string, distro string, version string, repo string, arch string, params *CreatePackageIndexParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/index", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPackagesByRepoRequest generates requests for ListPackagesByRepo
+func NewListPackagesByRepoRequest(server string, org string, distro string, version string, repo string, arch string, params *ListPackagesByRepoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreatePackageRequestWithBody generates requests for CreatePackage with any type of body
+func NewCreatePackageRequestWithBody(server string, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPackageRequest generates requests for GetPackage
+func NewGetPackageRequest(server string, org string, distro string, version string, repo string, arch string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPackageVersionRequest generates requests for GetPackageVersion
+func NewGetPackageVersionRequest(server string, org string, distro string, version string, repo string, arch string, name string, pkgVersion string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam6 string
+
+	pathParam6, err = runtime.StyleParamWithLocation("simple", false, "pkgVersion", runtime.ParamLocationPath, pkgVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/pkgs/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5, pathParam6)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUploadSessionRequest calls the generic CreateUploadSession builder with application/json body
+func NewCreateUploadSessionRequest(server string, org string, distro string, version string, repo string, arch string, body CreateUploadSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUploadSessionRequestWithBody(server, org, distro, version, repo, arch, "application/json", bodyReader)
+}
+
+// NewCreateUploadSessionRequestWithBody generates requests for CreateUploadSession with any type of body
+func NewCreateUploadSessionRequestWithBody(server string, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/uploads", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUploadSessionRequest generates requests for DeleteUploadSession
+func NewDeleteUploadSessionRequest(server string, org string, distro string, version string, repo string, arch string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/uploads/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUploadSessionRequest generates requests for GetUploadSession
+func NewGetUploadSessionRequest(server string, org string, distro string, version string, repo string, arch string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/uploads/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadChunkRequestWithBody generates requests for UploadChunk with any type of body
+func NewUploadChunkRequestWithBody(server string, org string, distro string, version string, repo string, arch string, id string, params *UploadChunkParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/uploads/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFinalizeUploadRequest calls the generic FinalizeUpload builder with application/json body
+func NewFinalizeUploadRequest(server string, org string, distro string, version string, repo string, arch string, id string, body FinalizeUploadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFinalizeUploadRequestWithBody(server, org, distro, version, repo, arch, id, "application/json", bodyReader)
+}
+
+// NewFinalizeUploadRequestWithBody generates requests for FinalizeUpload with any type of body
+func NewFinalizeUploadRequestWithBody(server string, org string, distro string, version string, repo string, arch string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/uploads/%s/finalize", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRepoFileRequest generates requests for GetRepoFile
+func NewGetRepoFileRequest(server string, org string, distro string, version string, repo string, arch string, file string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "file", runtime.ParamLocationPath, file)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadRepoFileRequestWithBody generates requests for UploadRepoFile with any type of body
+func NewUploadRepoFileRequestWithBody(server string, org string, distro string, version string, repo string, arch string, file string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "org", runtime.ParamLocationPath, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "distro", runtime.ParamLocationPath, distro)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "arch", runtime.ParamLocationPath, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam5 string
+
+	pathParam5, err = runtime.StyleParamWithLocation("simple", false, "file", runtime.ParamLocationPath, file)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/%s/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4, pathParam5)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetHealthPingWithResponse request
+	GetHealthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthPingResponse, error)
+
+	// GetHealthReadyWithResponse request
+	GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error)
+
+	// HeadHealthReadyWithResponse request
+	HeadHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeadHealthReadyResponse, error)
+
+	// ListOrganizationsWithResponse request
+	ListOrganizationsWithResponse(ctx context.Context, params *ListOrganizationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error)
+
+	// GetOrganizationWithResponse request
+	GetOrganizationWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+
+	// CreateRepoWithBodyWithResponse request with any body
+	CreateRepoWithBodyWithResponse(ctx context.Context, org string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error)
+
+	CreateRepoWithResponse(ctx context.Context, org string, body CreateRepoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error)
+
+	// ListAuditEntriesWithResponse request
+	ListAuditEntriesWithResponse(ctx context.Context, org string, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error)
+
+	// ListDistrosWithResponse request
+	ListDistrosWithResponse(ctx context.Context, org string, params *ListDistrosParams, reqEditors ...RequestEditorFn) (*ListDistrosResponse, error)
+
+	// ListReverseDependenciesWithResponse request
+	ListReverseDependenciesWithResponse(ctx context.Context, org string, params *ListReverseDependenciesParams, reqEditors ...RequestEditorFn) (*ListReverseDependenciesResponse, error)
+
+	// SearchPackagesWithResponse request
+	SearchPackagesWithResponse(ctx context.Context, org string, params *SearchPackagesParams, reqEditors ...RequestEditorFn) (*SearchPackagesResponse, error)
+
+	// GetOrgDistroWithResponse request
+	GetOrgDistroWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetOrgDistroResponse, error)
+
+	// GetKeyringWithResponse request
+	GetKeyringWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetKeyringResponse, error)
+
+	// RebuildKeyringWithResponse request
+	RebuildKeyringWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*RebuildKeyringResponse, error)
+
+	// ListSigningKeysWithResponse request
+	ListSigningKeysWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*ListSigningKeysResponse, error)
+
+	// CreateSigningKeyWithBodyWithResponse request with any body
+	CreateSigningKeyWithBodyWithResponse(ctx context.Context, org string, distro string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSigningKeyResponse, error)
+
+	CreateSigningKeyWithResponse(ctx context.Context, org string, distro string, body CreateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSigningKeyResponse, error)
+
+	// GetSigningKeyWithResponse request
+	GetSigningKeyWithResponse(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*GetSigningKeyResponse, error)
+
+	// UpdateSigningKeyWithBodyWithResponse request with any body
+	UpdateSigningKeyWithBodyWithResponse(ctx context.Context, org string, distro string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSigningKeyResponse, error)
+
+	UpdateSigningKeyWithResponse(ctx context.Context, org string, distro string, name string, body UpdateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSigningKeyResponse, error)
+
+	// GetSigningKeyPublicKeyWithResponse request
+	GetSigningKeyPublicKeyWithResponse(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*GetSigningKeyPublicKeyResponse, error)
+
+	// ListVersionsWithResponse request
+	ListVersionsWithResponse(ctx context.Context, org string, distro string, params *ListVersionsParams, reqEditors ...RequestEditorFn) (*ListVersionsResponse, error)
+
+	// ListReposWithResponse request
+	ListReposWithResponse(ctx context.Context, org string, distro string, version string, params *ListReposParams, reqEditors ...RequestEditorFn) (*ListReposResponse, error)
+
+	// FindRepoByNameWithResponse request
+	FindRepoByNameWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*FindRepoByNameResponse, error)
+
+	// ListArchesWithResponse request
+	ListArchesWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *ListArchesParams, reqEditors ...RequestEditorFn) (*ListArchesResponse, error)
+
+	// CheckRepoDependenciesWithResponse request
+	CheckRepoDependenciesWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *CheckRepoDependenciesParams, reqEditors ...RequestEditorFn) (*CheckRepoDependenciesResponse, error)
+
+	// GetMirrorStatusWithResponse request
+	GetMirrorStatusWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*GetMirrorStatusResponse, error)
+
+	// SyncMirrorWithResponse request
+	SyncMirrorWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *SyncMirrorParams, reqEditors ...RequestEditorFn) (*SyncMirrorResponse, error)
+
+	// CreateNoarchPackageWithBodyWithResponse request with any body
+	CreateNoarchPackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNoarchPackageResponse, error)
+
+	// ListSnapshotsWithResponse request
+	ListSnapshotsWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*ListSnapshotsResponse, error)
+
+	// CreateSnapshotWithBodyWithResponse request with any body
+	CreateSnapshotWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSnapshotResponse, error)
+
+	CreateSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSnapshotResponse, error)
+
+	// DeleteSnapshotWithResponse request
+	DeleteSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*DeleteSnapshotResponse, error)
+
+	// GetSnapshotWithResponse request
+	GetSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*GetSnapshotResponse, error)
+
+	// GetSnapshotFileWithResponse request
+	GetSnapshotFileWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, arch string, file string, reqEditors ...RequestEditorFn) (*GetSnapshotFileResponse, error)
+
+	// CreateTransactionWithResponse request
+	CreateTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+
+	// AbortTransactionWithResponse request
+	AbortTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*AbortTransactionResponse, error)
+
+	// GetTransactionWithResponse request
+	GetTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*GetTransactionResponse, error)
+
+	// CommitTransactionWithResponse request
+	CommitTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*CommitTransactionResponse, error)
+
+	// UploadTransactionFileWithBodyWithResponse request with any body
+	UploadTransactionFileWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTransactionFileResponse, error)
+
+	// CreatePackageIndexWithResponse request
+	CreatePackageIndexWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, params *CreatePackageIndexParams, reqEditors ...RequestEditorFn) (*CreatePackageIndexResponse, error)
+
+	// ListPackagesByRepoWithResponse request
+	ListPackagesByRepoWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, params *ListPackagesByRepoParams, reqEditors ...RequestEditorFn) (*ListPackagesByRepoResponse, error)
+
+	// CreatePackageWithBodyWithResponse request with any body
+	CreatePackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageResponse, error)
+
+	// GetPackageWithResponse request
+	GetPackageWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, reqEditors ...RequestEditorFn) (*GetPackageResponse, error)
+
+	// GetPackageVersionWithResponse request
+	GetPackageVersionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, pkgVersion string, reqEditors ...RequestEditorFn) (*GetPackageVersionResponse, error)
+
+	// CreateUploadSessionWithBodyWithResponse request with any body
+	CreateUploadSessionWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadSessionResponse, error)
+
+	CreateUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, body CreateUploadSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadSessionResponse, error)
+
+	// DeleteUploadSessionWithResponse request
+	DeleteUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*DeleteUploadSessionResponse, error)
+
+	// GetUploadSessionWithResponse request
+	GetUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*GetUploadSessionResponse, error)
+
+	// UploadChunkWithBodyWithResponse request with any body
+	UploadChunkWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadChunkResponse, error)
+
+	// FinalizeUploadWithBodyWithResponse request with any body
+	FinalizeUploadWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinalizeUploadResponse, error)
+
+	FinalizeUploadWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, body FinalizeUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*FinalizeUploadResponse, error)
+
+	// GetRepoFileWithResponse request
+	GetRepoFileWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, reqEditors ...RequestEditorFn) (*GetRepoFileResponse, error)
+
+	// UploadRepoFileWithBodyWithResponse request with any body
+	UploadRepoFileWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadRepoFileResponse, error)
+}
+
+type GetHealthPingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthPingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthPingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadHealthReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadHealthReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadHealthReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Organization
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Repo
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEntry
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDistrosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Distribution
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDistrosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDistrosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReverseDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReverseDependency
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReverseDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReverseDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchPackagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResults
+	JSON400      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchPackagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchPackagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgDistroResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgDistroResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgDistroResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKeyringResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKeyringResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKeyringResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RebuildKeyringResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RebuildKeyringResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RebuildKeyringResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSigningKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SigningKey
+	JSON400      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSigningKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSigningKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSigningKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKey
+	JSON400      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSigningKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSigningKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSigningKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKey
+	JSON400      *Error
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSigningKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSigningKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSigningKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKey
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSigningKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSigningKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSigningKeyPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSigningKeyPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSigningKeyPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoVersion
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReposResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Repo
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReposResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReposResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindRepoByNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FindRepoByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindRepoByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListArchesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Architecture
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListArchesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListArchesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckRepoDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DependencyReport
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckRepoDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckRepoDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMirrorStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MirrorStatus
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMirrorStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMirrorStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncMirrorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MirrorStatus
+	JSON404      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncMirrorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncMirrorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateNoarchPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NoarchPackage
+	JSON400      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateNoarchPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateNoarchPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Snapshot
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Snapshot
+	JSON400      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Snapshot
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSnapshotFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSnapshotFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSnapshotFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Transaction
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AbortTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Transaction
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommitTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Transaction
+	JSON404      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CommitTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommitTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadTransactionFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Transaction
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON413      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadTransactionFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadTransactionFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePackageIndexResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenerateIndex
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePackageIndexResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePackageIndexResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPackagesByRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Package
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPackagesByRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPackagesByRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Package
+	JSON409      *Error
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ListDistrosResponse) Status() string {
+func (r CreatePackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackageDetail
+	JSON404      *Error
+	JSON422      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPackageVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackageDetail
+	JSON404      *Error
+	JSON422      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackageVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackageVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUploadSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadSession
+	JSON400      *Error
+	JSON409      *Error
+	JSON413      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUploadSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUploadSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUploadSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUploadSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUploadSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadSession
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadChunkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadSession
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON413      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadChunkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadChunkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinalizeUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Package
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FinalizeUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinalizeUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepoFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON502      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepoFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepoFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadRepoFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Package
+	JSON400      *Error
+	JSON409      *Error
+	JSON413      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadRepoFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadRepoFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetHealthPingWithResponse request returning *GetHealthPingResponse
+func (c *ClientWithResponses) GetHealthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthPingResponse, error) {
+	rsp, err := c.GetHealthPing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthPingResponse(rsp)
+}
+
+// GetHealthReadyWithResponse request returning *GetHealthReadyResponse
+func (c *ClientWithResponses) GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error) {
+	rsp, err := c.GetHealthReady(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthReadyResponse(rsp)
+}
+
+// HeadHealthReadyWithResponse request returning *HeadHealthReadyResponse
+func (c *ClientWithResponses) HeadHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeadHealthReadyResponse, error) {
+	rsp, err := c.HeadHealthReady(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadHealthReadyResponse(rsp)
+}
+
+// ListOrganizationsWithResponse request returning *ListOrganizationsResponse
+func (c *ClientWithResponses) ListOrganizationsWithResponse(ctx context.Context, params *ListOrganizationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error) {
+	rsp, err := c.ListOrganizations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationsResponse(rsp)
+}
+
+// GetOrganizationWithResponse request returning *GetOrganizationResponse
+func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
+	rsp, err := c.GetOrganization(ctx, org, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationResponse(rsp)
+}
+
+// CreateRepoWithBodyWithResponse request with arbitrary body returning *CreateRepoResponse
+func (c *ClientWithResponses) CreateRepoWithBodyWithResponse(ctx context.Context, org string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error) {
+	rsp, err := c.CreateRepoWithBody(ctx, org, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRepoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRepoWithResponse(ctx context.Context, org string, body CreateRepoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error) {
+	rsp, err := c.CreateRepo(ctx, org, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRepoResponse(rsp)
+}
+
+// ListAuditEntriesWithResponse request returning *ListAuditEntriesResponse
+func (c *ClientWithResponses) ListAuditEntriesWithResponse(ctx context.Context, org string, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error) {
+	rsp, err := c.ListAuditEntries(ctx, org, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEntriesResponse(rsp)
+}
+
+// ListDistrosWithResponse request returning *ListDistrosResponse
+func (c *ClientWithResponses) ListDistrosWithResponse(ctx context.Context, org string, params *ListDistrosParams, reqEditors ...RequestEditorFn) (*ListDistrosResponse, error) {
+	rsp, err := c.ListDistros(ctx, org, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDistrosResponse(rsp)
+}
+
+// ListReverseDependenciesWithResponse request returning *ListReverseDependenciesResponse
+func (c *ClientWithResponses) ListReverseDependenciesWithResponse(ctx context.Context, org string, params *ListReverseDependenciesParams, reqEditors ...RequestEditorFn) (*ListReverseDependenciesResponse, error) {
+	rsp, err := c.ListReverseDependencies(ctx, org, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReverseDependenciesResponse(rsp)
+}
+
+// SearchPackagesWithResponse request returning *SearchPackagesResponse
+func (c *ClientWithResponses) SearchPackagesWithResponse(ctx context.Context, org string, params *SearchPackagesParams, reqEditors ...RequestEditorFn) (*SearchPackagesResponse, error) {
+	rsp, err := c.SearchPackages(ctx, org, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchPackagesResponse(rsp)
+}
+
+// GetOrgDistroWithResponse request returning *GetOrgDistroResponse
+func (c *ClientWithResponses) GetOrgDistroWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetOrgDistroResponse, error) {
+	rsp, err := c.GetOrgDistro(ctx, org, distro, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgDistroResponse(rsp)
+}
+
+// GetKeyringWithResponse request returning *GetKeyringResponse
+func (c *ClientWithResponses) GetKeyringWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetKeyringResponse, error) {
+	rsp, err := c.GetKeyring(ctx, org, distro, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKeyringResponse(rsp)
+}
+
+// RebuildKeyringWithResponse request returning *RebuildKeyringResponse
+func (c *ClientWithResponses) RebuildKeyringWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*RebuildKeyringResponse, error) {
+	rsp, err := c.RebuildKeyring(ctx, org, distro, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRebuildKeyringResponse(rsp)
+}
+
+// ListSigningKeysWithResponse request returning *ListSigningKeysResponse
+func (c *ClientWithResponses) ListSigningKeysWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*ListSigningKeysResponse, error) {
+	rsp, err := c.ListSigningKeys(ctx, org, distro, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSigningKeysResponse(rsp)
+}
+
+// CreateSigningKeyWithBodyWithResponse request with arbitrary body returning *CreateSigningKeyResponse
+func (c *ClientWithResponses) CreateSigningKeyWithBodyWithResponse(ctx context.Context, org string, distro string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSigningKeyResponse, error) {
+	rsp, err := c.CreateSigningKeyWithBody(ctx, org, distro, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSigningKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSigningKeyWithResponse(ctx context.Context, org string, distro string, body CreateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSigningKeyResponse, error) {
+	rsp, err := c.CreateSigningKey(ctx, org, distro, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSigningKeyResponse(rsp)
+}
+
+// GetSigningKeyWithResponse request returning *GetSigningKeyResponse
+func (c *ClientWithResponses) GetSigningKeyWithResponse(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*GetSigningKeyResponse, error) {
+	rsp, err := c.GetSigningKey(ctx, org, distro, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSigningKeyResponse(rsp)
+}
+
+// UpdateSigningKeyWithBodyWithResponse request with arbitrary body returning *UpdateSigningKeyResponse
+func (c *ClientWithResponses) UpdateSigningKeyWithBodyWithResponse(ctx context.Context, org string, distro string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSigningKeyResponse, error) {
+	rsp, err := c.UpdateSigningKeyWithBody(ctx, org, distro, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSigningKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSigningKeyWithResponse(ctx context.Context, org string, distro string, name string, body UpdateSigningKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSigningKeyResponse, error) {
+	rsp, err := c.UpdateSigningKey(ctx, org, distro, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSigningKeyResponse(rsp)
+}
+
+// GetSigningKeyPublicKeyWithResponse request returning *GetSigningKeyPublicKeyResponse
+func (c *ClientWithResponses) GetSigningKeyPublicKeyWithResponse(ctx context.Context, org string, distro string, name string, reqEditors ...RequestEditorFn) (*GetSigningKeyPublicKeyResponse, error) {
+	rsp, err := c.GetSigningKeyPublicKey(ctx, org, distro, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSigningKeyPublicKeyResponse(rsp)
+}
+
+// ListVersionsWithResponse request returning *ListVersionsResponse
+func (c *ClientWithResponses) ListVersionsWithResponse(ctx context.Context, org string, distro string, params *ListVersionsParams, reqEditors ...RequestEditorFn) (*ListVersionsResponse, error) {
+	rsp, err := c.ListVersions(ctx, org, distro, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVersionsResponse(rsp)
+}
+
+// ListReposWithResponse request returning *ListReposResponse
+func (c *ClientWithResponses) ListReposWithResponse(ctx context.Context, org string, distro string, version string, params *ListReposParams, reqEditors ...RequestEditorFn) (*ListReposResponse, error) {
+	rsp, err := c.ListRepos(ctx, org, distro, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReposResponse(rsp)
+}
+
+// FindRepoByNameWithResponse request returning *FindRepoByNameResponse
+func (c *ClientWithResponses) FindRepoByNameWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*FindRepoByNameResponse, error) {
+	rsp, err := c.FindRepoByName(ctx, org, distro, version, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindRepoByNameResponse(rsp)
+}
+
+// ListArchesWithResponse request returning *ListArchesResponse
+func (c *ClientWithResponses) ListArchesWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *ListArchesParams, reqEditors ...RequestEditorFn) (*ListArchesResponse, error) {
+	rsp, err := c.ListArches(ctx, org, distro, version, repo, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListArchesResponse(rsp)
+}
+
+// CheckRepoDependenciesWithResponse request returning *CheckRepoDependenciesResponse
+func (c *ClientWithResponses) CheckRepoDependenciesWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *CheckRepoDependenciesParams, reqEditors ...RequestEditorFn) (*CheckRepoDependenciesResponse, error) {
+	rsp, err := c.CheckRepoDependencies(ctx, org, distro, version, repo, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckRepoDependenciesResponse(rsp)
+}
+
+// GetMirrorStatusWithResponse request returning *GetMirrorStatusResponse
+func (c *ClientWithResponses) GetMirrorStatusWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*GetMirrorStatusResponse, error) {
+	rsp, err := c.GetMirrorStatus(ctx, org, distro, version, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMirrorStatusResponse(rsp)
+}
+
+// SyncMirrorWithResponse request returning *SyncMirrorResponse
+func (c *ClientWithResponses) SyncMirrorWithResponse(ctx context.Context, org string, distro string, version string, repo string, params *SyncMirrorParams, reqEditors ...RequestEditorFn) (*SyncMirrorResponse, error) {
+	rsp, err := c.SyncMirror(ctx, org, distro, version, repo, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncMirrorResponse(rsp)
+}
+
+// CreateNoarchPackageWithBodyWithResponse request with arbitrary body returning *CreateNoarchPackageResponse
+func (c *ClientWithResponses) CreateNoarchPackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNoarchPackageResponse, error) {
+	rsp, err := c.CreateNoarchPackageWithBody(ctx, org, distro, version, repo, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNoarchPackageResponse(rsp)
+}
+
+// ListSnapshotsWithResponse request returning *ListSnapshotsResponse
+func (c *ClientWithResponses) ListSnapshotsWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*ListSnapshotsResponse, error) {
+	rsp, err := c.ListSnapshots(ctx, org, distro, version, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSnapshotsResponse(rsp)
+}
+
+// CreateSnapshotWithBodyWithResponse request with arbitrary body returning *CreateSnapshotResponse
+func (c *ClientWithResponses) CreateSnapshotWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSnapshotResponse, error) {
+	rsp, err := c.CreateSnapshotWithBody(ctx, org, distro, version, repo, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSnapshotResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSnapshotResponse, error) {
+	rsp, err := c.CreateSnapshot(ctx, org, distro, version, repo, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSnapshotResponse(rsp)
+}
+
+// DeleteSnapshotWithResponse request returning *DeleteSnapshotResponse
+func (c *ClientWithResponses) DeleteSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*DeleteSnapshotResponse, error) {
+	rsp, err := c.DeleteSnapshot(ctx, org, distro, version, repo, snapshot, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSnapshotResponse(rsp)
+}
+
+// GetSnapshotWithResponse request returning *GetSnapshotResponse
+func (c *ClientWithResponses) GetSnapshotWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, reqEditors ...RequestEditorFn) (*GetSnapshotResponse, error) {
+	rsp, err := c.GetSnapshot(ctx, org, distro, version, repo, snapshot, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSnapshotResponse(rsp)
+}
+
+// GetSnapshotFileWithResponse request returning *GetSnapshotFileResponse
+func (c *ClientWithResponses) GetSnapshotFileWithResponse(ctx context.Context, org string, distro string, version string, repo string, snapshot string, arch string, file string, reqEditors ...RequestEditorFn) (*GetSnapshotFileResponse, error) {
+	rsp, err := c.GetSnapshotFile(ctx, org, distro, version, repo, snapshot, arch, file, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSnapshotFileResponse(rsp)
+}
+
+// CreateTransactionWithResponse request returning *CreateTransactionResponse
+func (c *ClientWithResponses) CreateTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
+	rsp, err := c.CreateTransaction(ctx, org, distro, version, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionResponse(rsp)
+}
+
+// AbortTransactionWithResponse request returning *AbortTransactionResponse
+func (c *ClientWithResponses) AbortTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*AbortTransactionResponse, error) {
+	rsp, err := c.AbortTransaction(ctx, org, distro, version, repo, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortTransactionResponse(rsp)
+}
+
+// GetTransactionWithResponse request returning *GetTransactionResponse
+func (c *ClientWithResponses) GetTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*GetTransactionResponse, error) {
+	rsp, err := c.GetTransaction(ctx, org, distro, version, repo, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionResponse(rsp)
+}
+
+// CommitTransactionWithResponse request returning *CommitTransactionResponse
+func (c *ClientWithResponses) CommitTransactionWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, reqEditors ...RequestEditorFn) (*CommitTransactionResponse, error) {
+	rsp, err := c.CommitTransaction(ctx, org, distro, version, repo, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitTransactionResponse(rsp)
+}
+
+// UploadTransactionFileWithBodyWithResponse request with arbitrary body returning *UploadTransactionFileResponse
+func (c *ClientWithResponses) UploadTransactionFileWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, id string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTransactionFileResponse, error) {
+	rsp, err := c.UploadTransactionFileWithBody(ctx, org, distro, version, repo, id, arch, file, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadTransactionFileResponse(rsp)
+}
+
+// CreatePackageIndexWithResponse request returning *CreatePackageIndexResponse
+func (c *ClientWithResponses) CreatePackageIndexWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, params *CreatePackageIndexParams, reqEditors ...RequestEditorFn) (*CreatePackageIndexResponse, error) {
+	rsp, err := c.CreatePackageIndex(ctx, org, distro, version, repo, arch, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageIndexResponse(rsp)
+}
+
+// ListPackagesByRepoWithResponse request returning *ListPackagesByRepoResponse
+func (c *ClientWithResponses) ListPackagesByRepoWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, params *ListPackagesByRepoParams, reqEditors ...RequestEditorFn) (*ListPackagesByRepoResponse, error) {
+	rsp, err := c.ListPackagesByRepo(ctx, org, distro, version, repo, arch, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPackagesByRepoResponse(rsp)
+}
+
+// CreatePackageWithBodyWithResponse request with arbitrary body returning *CreatePackageResponse
+func (c *ClientWithResponses) CreatePackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageResponse, error) {
+	rsp, err := c.CreatePackageWithBody(ctx, org, distro, version, repo, arch, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageResponse(rsp)
+}
+
+// GetPackageWithResponse request returning *GetPackageResponse
+func (c *ClientWithResponses) GetPackageWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, reqEditors ...RequestEditorFn) (*GetPackageResponse, error) {
+	rsp, err := c.GetPackage(ctx, org, distro, version, repo, arch, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackageResponse(rsp)
+}
+
+// GetPackageVersionWithResponse request returning *GetPackageVersionResponse
+func (c *ClientWithResponses) GetPackageVersionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, name string, pkgVersion string, reqEditors ...RequestEditorFn) (*GetPackageVersionResponse, error) {
+	rsp, err := c.GetPackageVersion(ctx, org, distro, version, repo, arch, name, pkgVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackageVersionResponse(rsp)
+}
+
+// CreateUploadSessionWithBodyWithResponse request with arbitrary body returning *CreateUploadSessionResponse
+func (c *ClientWithResponses) CreateUploadSessionWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadSessionResponse, error) {
+	rsp, err := c.CreateUploadSessionWithBody(ctx, org, distro, version, repo, arch, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, body CreateUploadSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadSessionResponse, error) {
+	rsp, err := c.CreateUploadSession(ctx, org, distro, version, repo, arch, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadSessionResponse(rsp)
+}
+
+// DeleteUploadSessionWithResponse request returning *DeleteUploadSessionResponse
+func (c *ClientWithResponses) DeleteUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*DeleteUploadSessionResponse, error) {
+	rsp, err := c.DeleteUploadSession(ctx, org, distro, version, repo, arch, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUploadSessionResponse(rsp)
+}
+
+// GetUploadSessionWithResponse request returning *GetUploadSessionResponse
+func (c *ClientWithResponses) GetUploadSessionWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, reqEditors ...RequestEditorFn) (*GetUploadSessionResponse, error) {
+	rsp, err := c.GetUploadSession(ctx, org, distro, version, repo, arch, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadSessionResponse(rsp)
+}
+
+// UploadChunkWithBodyWithResponse request with arbitrary body returning *UploadChunkResponse
+func (c *ClientWithResponses) UploadChunkWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, params *UploadChunkParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadChunkResponse, error) {
+	rsp, err := c.UploadChunkWithBody(ctx, org, distro, version, repo, arch, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadChunkResponse(rsp)
+}
+
+// FinalizeUploadWithBodyWithResponse request with arbitrary body returning *FinalizeUploadResponse
+func (c *ClientWithResponses) FinalizeUploadWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinalizeUploadResponse, error) {
+	rsp, err := c.FinalizeUploadWithBody(ctx, org, distro, version, repo, arch, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinalizeUploadResponse(rsp)
+}
+
+func (c *ClientWithResponses) FinalizeUploadWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, id string, body FinalizeUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*FinalizeUploadResponse, error) {
+	rsp, err := c.FinalizeUpload(ctx, org, distro, version, repo, arch, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinalizeUploadResponse(rsp)
+}
+
+// GetRepoFileWithResponse request returning *GetRepoFileResponse
+func (c *ClientWithResponses) GetRepoFileWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, reqEditors ...RequestEditorFn) (*GetRepoFileResponse, error) {
+	rsp, err := c.GetRepoFile(ctx, org, distro, version, repo, arch, file, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepoFileResponse(rsp)
+}
+
+// UploadRepoFileWithBodyWithResponse request with arbitrary body returning *UploadRepoFileResponse
+func (c *ClientWithResponses) UploadRepoFileWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, file string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadRepoFileResponse, error) {
+	rsp, err := c.UploadRepoFileWithBody(ctx, org, distro, version, repo, arch, file, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadRepoFileResponse(rsp)
+}
+
+// ParseGetHealthPingResponse parses an HTTP response from a GetHealthPingWithResponse call
+func ParseGetHealthPingResponse(rsp *http.Response) (*GetHealthPingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthPingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthReadyResponse parses an HTTP response from a GetHealthReadyWithResponse call
+func ParseGetHealthReadyResponse(rsp *http.Response) (*GetHealthReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHeadHealthReadyResponse parses an HTTP response from a HeadHealthReadyWithResponse call
+func ParseHeadHealthReadyResponse(rsp *http.Response) (*HeadHealthReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HeadHealthReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizationsResponse parses an HTTP response from a ListOrganizationsWithResponse call
+func ParseListOrganizationsResponse(rsp *http.Response) (*ListOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
+func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRepoResponse parses an HTTP response from a CreateRepoWithResponse call
+func ParseCreateRepoResponse(rsp *http.Response) (*CreateRepoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRepoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Repo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditEntriesResponse parses an HTTP response from a ListAuditEntriesWithResponse call
+func ParseListAuditEntriesResponse(rsp *http.Response) (*ListAuditEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseListDistrosResponse parses an HTTP response from a ListDistrosWithResponse call
+func ParseListDistrosResponse(rsp *http.Response) (*ListDistrosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDistrosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Distribution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListReverseDependenciesResponse parses an HTTP response from a ListReverseDependenciesWithResponse call
+func ParseListReverseDependenciesResponse(rsp *http.Response) (*ListReverseDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReverseDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ReverseDependency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchPackagesResponse parses an HTTP response from a SearchPackagesWithResponse call
+func ParseSearchPackagesResponse(rsp *http.Response) (*SearchPackagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchPackagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgDistroResponse parses an HTTP response from a GetOrgDistroWithResponse call
+func ParseGetOrgDistroResponse(rsp *http.Response) (*GetOrgDistroResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgDistroResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKeyringResponse parses an HTTP response from a GetKeyringWithResponse call
+func ParseGetKeyringResponse(rsp *http.Response) (*GetKeyringResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKeyringResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRebuildKeyringResponse parses an HTTP response from a RebuildKeyringWithResponse call
+func ParseRebuildKeyringResponse(rsp *http.Response) (*RebuildKeyringResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RebuildKeyringResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSigningKeysResponse parses an HTTP response from a ListSigningKeysWithResponse call
+func ParseListSigningKeysResponse(rsp *http.Response) (*ListSigningKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSigningKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSigningKeyResponse parses an HTTP response from a CreateSigningKeyWithResponse call
+func ParseCreateSigningKeyResponse(rsp *http.Response) (*CreateSigningKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSigningKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSigningKeyResponse parses an HTTP response from a GetSigningKeyWithResponse call
+func ParseGetSigningKeyResponse(rsp *http.Response) (*GetSigningKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSigningKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSigningKeyResponse parses an HTTP response from a UpdateSigningKeyWithResponse call
+func ParseUpdateSigningKeyResponse(rsp *http.Response) (*UpdateSigningKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSigningKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSigningKeyPublicKeyResponse parses an HTTP response from a GetSigningKeyPublicKeyWithResponse call
+func ParseGetSigningKeyPublicKeyResponse(rsp *http.Response) (*GetSigningKeyPublicKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSigningKeyPublicKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVersionsResponse parses an HTTP response from a ListVersionsWithResponse call
+func ParseListVersionsResponse(rsp *http.Response) (*ListVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListReposResponse parses an HTTP response from a ListReposWithResponse call
+func ParseListReposResponse(rsp *http.Response) (*ListReposResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReposResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Repo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindRepoByNameResponse parses an HTTP response from a FindRepoByNameWithResponse call
+func ParseFindRepoByNameResponse(rsp *http.Response) (*FindRepoByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindRepoByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListArchesResponse parses an HTTP response from a ListArchesWithResponse call
+func ParseListArchesResponse(rsp *http.Response) (*ListArchesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListArchesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Architecture
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCheckRepoDependenciesResponse parses an HTTP response from a CheckRepoDependenciesWithResponse call
+func ParseCheckRepoDependenciesResponse(rsp *http.Response) (*CheckRepoDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckRepoDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DependencyReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMirrorStatusResponse parses an HTTP response from a GetMirrorStatusWithResponse call
+func ParseGetMirrorStatusResponse(rsp *http.Response) (*GetMirrorStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMirrorStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MirrorStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncMirrorResponse parses an HTTP response from a SyncMirrorWithResponse call
+func ParseSyncMirrorResponse(rsp *http.Response) (*SyncMirrorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncMirrorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MirrorStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNoarchPackageResponse parses an HTTP response from a CreateNoarchPackageWithResponse call
+func ParseCreateNoarchPackageResponse(rsp *http.Response) (*CreateNoarchPackageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNoarchPackageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NoarchPackage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSnapshotsResponse parses an HTTP response from a ListSnapshotsWithResponse call
+func ParseListSnapshotsResponse(rsp *http.Response) (*ListSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSnapshotResponse parses an HTTP response from a CreateSnapshotWithResponse call
+func ParseCreateSnapshotResponse(rsp *http.Response) (*CreateSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSnapshotResponse parses an HTTP response from a DeleteSnapshotWithResponse call
+func ParseDeleteSnapshotResponse(rsp *http.Response) (*DeleteSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSnapshotResponse parses an HTTP response from a GetSnapshotWithResponse call
+func ParseGetSnapshotResponse(rsp *http.Response) (*GetSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSnapshotFileResponse parses an HTTP response from a GetSnapshotFileWithResponse call
+func ParseGetSnapshotFileResponse(rsp *http.Response) (*GetSnapshotFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSnapshotFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTransactionResponse parses an HTTP response from a CreateTransactionWithResponse call
+func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAbortTransactionResponse parses an HTTP response from a AbortTransactionWithResponse call
+func ParseAbortTransactionResponse(rsp *http.Response) (*AbortTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AbortTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionResponse parses an HTTP response from a GetTransactionWithResponse call
+func ParseGetTransactionResponse(rsp *http.Response) (*GetTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCommitTransactionResponse parses an HTTP response from a CommitTransactionWithResponse call
+func ParseCommitTransactionResponse(rsp *http.Response) (*CommitTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CommitTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadTransactionFileResponse parses an HTTP response from a UploadTransactionFileWithResponse call
+func ParseUploadTransactionFileResponse(rsp *http.Response) (*UploadTransactionFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadTransactionFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePackageIndexResponse parses an HTTP response from a CreatePackageIndexWithResponse call
+func ParseCreatePackageIndexResponse(rsp *http.Response) (*CreatePackageIndexResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePackageIndexResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenerateIndex
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPackagesByRepoResponse parses an HTTP response from a ListPackagesByRepoWithResponse call
+func ParseListPackagesByRepoResponse(rsp *http.Response) (*ListPackagesByRepoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPackagesByRepoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Package
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePackageResponse parses an HTTP response from a CreatePackageWithResponse call
+func ParseCreatePackageResponse(rsp *http.Response) (*CreatePackageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePackageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Package
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPackageResponse parses an HTTP response from a GetPackageWithResponse call
+func ParseGetPackageResponse(rsp *http.Response) (*GetPackageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackageDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPackageVersionResponse parses an HTTP response from a GetPackageVersionWithResponse call
+func ParseGetPackageVersionResponse(rsp *http.Response) (*GetPackageVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackageVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackageDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUploadSessionResponse parses an HTTP response from a CreateUploadSessionWithResponse call
+func ParseCreateUploadSessionResponse(rsp *http.Response) (*CreateUploadSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUploadSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UploadSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUploadSessionResponse parses an HTTP response from a DeleteUploadSessionWithResponse call
+func ParseDeleteUploadSessionResponse(rsp *http.Response) (*DeleteUploadSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUploadSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadSessionResponse parses an HTTP response from a GetUploadSessionWithResponse call
+func ParseGetUploadSessionResponse(rsp *http.Response) (*GetUploadSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadChunkResponse parses an HTTP response from a UploadChunkWithResponse call
+func ParseUploadChunkResponse(rsp *http.Response) (*UploadChunkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadChunkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFinalizeUploadResponse parses an HTTP response from a FinalizeUploadWithResponse call
+func ParseFinalizeUploadResponse(rsp *http.Response) (*FinalizeUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FinalizeUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Package
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRepoFileResponse parses an HTTP response from a GetRepoFileWithResponse call
+func ParseGetRepoFileResponse(rsp *http.Response) (*GetRepoFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRepoFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadRepoFileResponse parses an HTTP response from a UploadRepoFileWithResponse call
+func ParseUploadRepoFileResponse(rsp *http.Response) (*UploadRepoFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadRepoFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Package
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /health/ping)
+	GetHealthPing(ctx echo.Context) error
+
+	// (GET /health/ready)
+	GetHealthReady(ctx echo.Context) error
+
+	// (HEAD /health/ready)
+	HeadHealthReady(ctx echo.Context) error
+
+	// (GET /orgs)
+	ListOrganizations(ctx echo.Context, params ListOrganizationsParams) error
+
+	// (GET /{org})
+	GetOrganization(ctx echo.Context, org string) error
+
+	// (POST /{org})
+	CreateRepo(ctx echo.Context, org string) error
+
+	// (GET /{org}/audit)
+	ListAuditEntries(ctx echo.Context, org string, params ListAuditEntriesParams) error
+
+	// (GET /{org}/distros)
+	ListDistros(ctx echo.Context, org string, params ListDistrosParams) error
+
+	// (GET /{org}/rdepends)
+	ListReverseDependencies(ctx echo.Context, org string, params ListReverseDependenciesParams) error
+
+	// (GET /{org}/search)
+	SearchPackages(ctx echo.Context, org string, params SearchPackagesParams) error
+
+	// (GET /{org}/{distro})
+	GetOrgDistro(ctx echo.Context, org string, distro string) error
+
+	// (GET /{org}/{distro}/keyring)
+	GetKeyring(ctx echo.Context, org string, distro string) error
+
+	// (POST /{org}/{distro}/keyring)
+	RebuildKeyring(ctx echo.Context, org string, distro string) error
+
+	// (GET /{org}/{distro}/keys)
+	ListSigningKeys(ctx echo.Context, org string, distro string) error
+
+	// (POST /{org}/{distro}/keys)
+	CreateSigningKey(ctx echo.Context, org string, distro string) error
+
+	// (GET /{org}/{distro}/keys/{name})
+	GetSigningKey(ctx echo.Context, org string, distro string, name string) error
+
+	// (PATCH /{org}/{distro}/keys/{name})
+	UpdateSigningKey(ctx echo.Context, org string, distro string, name string) error
+
+	// (GET /{org}/{distro}/keys/{name}/pub)
+	GetSigningKeyPublicKey(ctx echo.Context, org string, distro string, name string) error
+
+	// (GET /{org}/{distro}/versions)
+	ListVersions(ctx echo.Context, org string, distro string, params ListVersionsParams) error
+
+	// (GET /{org}/{distro}/{version}/repos)
+	ListRepos(ctx echo.Context, org string, distro string, version string, params ListReposParams) error
+
+	// (GET /{org}/{distro}/{version}/{repo})
+	FindRepoByName(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/architectures)
+	ListArches(ctx echo.Context, org string, distro string, version string, repo string, params ListArchesParams) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/depcheck)
+	CheckRepoDependencies(ctx echo.Context, org string, distro string, version string, repo string, params CheckRepoDependenciesParams) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/mirror)
+	GetMirrorStatus(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/mirror/sync)
+	SyncMirror(ctx echo.Context, org string, distro string, version string, repo string, params SyncMirrorParams) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/pkgs)
+	CreateNoarchPackage(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/snapshots)
+	ListSnapshots(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/snapshots)
+	CreateSnapshot(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (DELETE /{org}/{distro}/{version}/{repo}/snapshots/{snapshot})
+	DeleteSnapshot(ctx echo.Context, org string, distro string, version string, repo string, snapshot string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/snapshots/{snapshot})
+	GetSnapshot(ctx echo.Context, org string, distro string, version string, repo string, snapshot string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/snapshots/{snapshot}/{arch}/{file})
+	GetSnapshotFile(ctx echo.Context, org string, distro string, version string, repo string, snapshot string, arch string, file string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/transactions)
+	CreateTransaction(ctx echo.Context, org string, distro string, version string, repo string) error
+
+	// (DELETE /{org}/{distro}/{version}/{repo}/transactions/{id})
+	AbortTransaction(ctx echo.Context, org string, distro string, version string, repo string, id string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/transactions/{id})
+	GetTransaction(ctx echo.Context, org string, distro string, version string, repo string, id string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/transactions/{id}/commit)
+	CommitTransaction(ctx echo.Context, org string, distro string, version string, repo string, id string) error
+
+	// (PUT /{org}/{distro}/{version}/{repo}/transactions/{id}/{arch}/{file})
+	UploadTransactionFile(ctx echo.Context, org string, distro string, version string, repo string, id string, arch string, file string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/{arch}/index)
+	CreatePackageIndex(ctx echo.Context, org string, distro string, version string, repo string, arch string, params CreatePackageIndexParams) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/{arch}/pkgs)
+	ListPackagesByRepo(ctx echo.Context, org string, distro string, version string, repo string, arch string, params ListPackagesByRepoParams) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/{arch}/pkgs)
+	CreatePackage(ctx echo.Context, org string, distro string, version string, repo string, arch string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/{arch}/pkgs/{name})
+	GetPackage(ctx echo.Context, org string, distro string, version string, repo string, arch string, name string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/{arch}/pkgs/{name}/{pkgVersion})
+	GetPackageVersion(ctx echo.Context, org string, distro string, version string, repo string, arch string, name string, pkgVersion string) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/{arch}/uploads)
+	CreateUploadSession(ctx echo.Context, org string, distro string, version string, repo string, arch string) error
+
+	// (DELETE /{org}/{distro}/{version}/{repo}/{arch}/uploads/{id})
+	DeleteUploadSession(ctx echo.Context, org string, distro string, version string, repo string, arch string, id string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/{arch}/uploads/{id})
+	GetUploadSession(ctx echo.Context, org string, distro string, version string, repo string, arch string, id string) error
+
+	// (PUT /{org}/{distro}/{version}/{repo}/{arch}/uploads/{id})
+	UploadChunk(ctx echo.Context, org string, distro string, version string, repo string, arch string, id string, params UploadChunkParams) error
+
+	// (POST /{org}/{distro}/{version}/{repo}/{arch}/uploads/{id}/finalize)
+	FinalizeUpload(ctx echo.Context, org string, distro string, version string, repo string, arch string, id string) error
+
+	// (GET /{org}/{distro}/{version}/{repo}/{arch}/{file})
+	GetRepoFile(ctx echo.Context, org string, distro string, version string, repo string, arch string, file string) error
+
+	// (PUT /{org}/{distro}/{version}/{repo}/{arch}/{file})
+	UploadRepoFile(ctx echo.Context, org string, distro string, version string, repo string, arch string, file string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetHealthPing converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthPing(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthPing(ctx)
+	return err
+}
+
+// GetHealthReady converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthReady(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthReady(ctx)
+	return err
+}
+
+// HeadHealthReady converts echo context to params.
+func (w *ServerInterfaceWrapper) HeadHealthReady(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HeadHealthReady(ctx)
+	return err
+}
+
+// ListOrganizations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrganizations(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrganizations(ctx, params)
+	return err
+}
+
+// GetOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganization(ctx, org)
+	return err
+}
+
+// CreateRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateRepo(ctx, org)
+	return err
+}
+
+// ListAuditEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAuditEntries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", ctx.QueryParams(), &params.Operation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operation: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAuditEntries(ctx, org, params)
+	return err
+}
+
+// ListDistros converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDistros(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDistrosParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDistros(ctx, org, params)
+	return err
+}
+
+// ListReverseDependencies converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReverseDependencies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReverseDependenciesParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "distro" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "distro", ctx.QueryParams(), &params.Distro)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Optional query parameter "distroVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "distroVersion", ctx.QueryParams(), &params.DistroVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distroVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", ctx.QueryParams(), &params.Depth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter depth: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReverseDependencies(ctx, org, params)
+	return err
+}
+
+// SearchPackages converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchPackages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchPackagesParams
+	// ------------- Optional query parameter "distro" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "distro", ctx.QueryParams(), &params.Distro)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Optional query parameter "distroVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "distroVersion", ctx.QueryParams(), &params.DistroVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distroVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "repo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repo", ctx.QueryParams(), &params.Repo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Optional query parameter "arch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "arch", ctx.QueryParams(), &params.Arch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "provides" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provides", ctx.QueryParams(), &params.Provides)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provides: %s", err))
+	}
+
+	// ------------- Optional query parameter "origin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", ctx.QueryParams(), &params.Origin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter origin: %s", err))
+	}
+
+	// ------------- Optional query parameter "maintainer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maintainer", ctx.QueryParams(), &params.Maintainer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maintainer: %s", err))
+	}
+
+	// ------------- Optional query parameter "versionRange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "versionRange", ctx.QueryParams(), &params.VersionRange)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionRange: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchPackages(ctx, org, params)
+	return err
+}
+
+// GetOrgDistro converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrgDistro(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrgDistro(ctx, org, distro)
+	return err
+}
+
+// GetKeyring converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKeyring(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKeyring(ctx, org, distro)
+	return err
+}
+
+// RebuildKeyring converts echo context to params.
+func (w *ServerInterfaceWrapper) RebuildKeyring(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RebuildKeyring(ctx, org, distro)
+	return err
+}
+
+// ListSigningKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSigningKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSigningKeys(ctx, org, distro)
+	return err
+}
+
+// CreateSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSigningKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSigningKey(ctx, org, distro)
+	return err
+}
+
+// GetSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSigningKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSigningKey(ctx, org, distro, name)
+	return err
+}
+
+// UpdateSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSigningKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSigningKey(ctx, org, distro, name)
+	return err
+}
+
+// GetSigningKeyPublicKey converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSigningKeyPublicKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSigningKeyPublicKey(ctx, org, distro, name)
+	return err
+}
+
+// ListVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVersionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListVersions(ctx, org, distro, params)
+	return err
+}
+
+// ListRepos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRepos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReposParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRepos(ctx, org, distro, version, params)
+	return err
+}
+
+// FindRepoByName converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRepoByName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindRepoByName(ctx, org, distro, version, repo)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDistrosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListArches converts echo context to params.
+func (w *ServerInterfaceWrapper) ListArches(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
+
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArchesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	return 0
-}
 
-type GetOrgDistroResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSONDefault  *Error
-}
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetOrgDistroResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListArches(ctx, org, distro, version, repo, params)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrgDistroResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CheckRepoDependencies converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckRepoDependencies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return 0
-}
 
-type ListVersionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RepoVersion
-	JSONDefault  *Error
-}
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-// Status returns HTTPResponse.Status
-func (r ListVersionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListVersionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return 0
-}
 
-type ListReposResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Repo
-	JSONDefault  *Error
-}
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-// Status returns HTTPResponse.Status
-func (r ListReposResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListReposResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckRepoDependenciesParams
+	// ------------- Optional query parameter "arch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "arch", ctx.QueryParams(), &params.Arch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
-	return 0
-}
 
-type FindRepoByNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSONDefault  *Error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CheckRepoDependencies(ctx, org, distro, version, repo, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r FindRepoByNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetMirrorStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMirrorStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindRepoByNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return 0
-}
 
-type ListArchesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Architecture
-	JSONDefault  *Error
-}
+	// ------------- Path parameter "version" -------------
+	var version string
 
-// Status returns HTTPResponse.Status
-func (r ListArchesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListArchesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return 0
-}
 
-type CreatePackageIndexResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSONDefault  *Error
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMirrorStatus(ctx, org, distro, version, repo)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r CreatePackageIndexResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SyncMirror converts echo context to params.
+func (w *ServerInterfaceWrapper) SyncMirror(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePackageIndexResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return 0
-}
 
-type ListPackagesByRepoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Package
-	JSONDefault  *Error
-}
+	// ------------- Path parameter "version" -------------
+	var version string
 
-// Status returns HTTPResponse.Status
-func (r ListPackagesByRepoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPackagesByRepoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return 0
-}
 
-type CreatePackageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Package
-	JSONDefault  *Error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-// Status returns HTTPResponse.Status
-func (r CreatePackageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncMirrorParams
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SyncMirror(ctx, org, distro, version, repo, params)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePackageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateNoarchPackage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateNoarchPackage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return 0
-}
 
-// GetHealthPingWithResponse request returning *GetHealthPingResponse
-func (c *ClientWithResponses) GetHealthPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthPingResponse, error) {
-	rsp, err := c.GetHealthPing(ctx, reqEditors...)
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return ParseGetHealthPingResponse(rsp)
-}
 
-// GetHealthReadyWithResponse request returning *GetHealthReadyResponse
-func (c *ClientWithResponses) GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error) {
-	rsp, err := c.GetHealthReady(ctx, reqEditors...)
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return ParseGetHealthReadyResponse(rsp)
-}
 
-// HeadHealthReadyWithResponse request returning *HeadHealthReadyResponse
-func (c *ClientWithResponses) HeadHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeadHealthReadyResponse, error) {
-	rsp, err := c.HeadHealthReady(ctx, reqEditors...)
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return ParseHeadHealthReadyResponse(rsp)
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateNoarchPackage(ctx, org, distro, version, repo)
+	return err
 }
 
-// ListOrganizationsWithResponse request returning *ListOrganizationsResponse
-func (c *ClientWithResponses) ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error) {
-	rsp, err := c.ListOrganizations(ctx, reqEditors...)
+// ListSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSnapshots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return ParseListOrganizationsResponse(rsp)
-}
 
-// GetOrganizationWithResponse request returning *GetOrganizationResponse
-func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
-	rsp, err := c.GetOrganization(ctx, org, reqEditors...)
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return ParseGetOrganizationResponse(rsp)
-}
 
-// CreateRepoWithBodyWithResponse request with arbitrary body returning *CreateRepoResponse
-func (c *ClientWithResponses) CreateRepoWithBodyWithResponse(ctx context.Context, org string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error) {
-	rsp, err := c.CreateRepoWithBody(ctx, org, contentType, body, reqEditors...)
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return ParseCreateRepoResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateRepoWithResponse(ctx context.Context, org string, body CreateRepoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRepoResponse, error) {
-	rsp, err := c.CreateRepo(ctx, org, body, reqEditors...)
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return ParseCreateRepoResponse(rsp)
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSnapshots(ctx, org, distro, version, repo)
+	return err
 }
 
-// ListDistrosWithResponse request returning *ListDistrosResponse
-func (c *ClientWithResponses) ListDistrosWithResponse(ctx context.Context, org string, reqEditors ...RequestEditorFn) (*ListDistrosResponse, error) {
-	rsp, err := c.ListDistros(ctx, org, reqEditors...)
+// CreateSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return ParseListDistrosResponse(rsp)
-}
 
-// GetOrgDistroWithResponse request returning *GetOrgDistroResponse
-func (c *ClientWithResponses) GetOrgDistroWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*GetOrgDistroResponse, error) {
-	rsp, err := c.GetOrgDistro(ctx, org, distro, reqEditors...)
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return ParseGetOrgDistroResponse(rsp)
-}
 
-// ListVersionsWithResponse request returning *ListVersionsResponse
-func (c *ClientWithResponses) ListVersionsWithResponse(ctx context.Context, org string, distro string, reqEditors ...RequestEditorFn) (*ListVersionsResponse, error) {
-	rsp, err := c.ListVersions(ctx, org, distro, reqEditors...)
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return ParseListVersionsResponse(rsp)
-}
 
-// ListReposWithResponse request returning *ListReposResponse
-func (c *ClientWithResponses) ListReposWithResponse(ctx context.Context, org string, distro string, version string, reqEditors ...RequestEditorFn) (*ListReposResponse, error) {
-	rsp, err := c.ListRepos(ctx, org, distro, version, reqEditors...)
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return ParseListReposResponse(rsp)
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSnapshot(ctx, org, distro, version, repo)
+	return err
 }
 
-// FindRepoByNameWithResponse request returning *FindRepoByNameResponse
-func (c *ClientWithResponses) FindRepoByNameWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*FindRepoByNameResponse, error) {
-	rsp, err := c.FindRepoByName(ctx, org, distro, version, repo, reqEditors...)
+// DeleteSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
-	return ParseFindRepoByNameResponse(rsp)
-}
 
-// ListArchesWithResponse request returning *ListArchesResponse
-func (c *ClientWithResponses) ListArchesWithResponse(ctx context.Context, org string, distro string, version string, repo string, reqEditors ...RequestEditorFn) (*ListArchesResponse, error) {
-	rsp, err := c.ListArches(ctx, org, distro, version, repo, reqEditors...)
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
-	return ParseListArchesResponse(rsp)
-}
 
-// CreatePackageIndexWithResponse request returning *CreatePackageIndexResponse
-func (c *ClientWithResponses) CreatePackageIndexWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*CreatePackageIndexResponse, error) {
-	rsp, err := c.CreatePackageIndex(ctx, org, distro, version, repo, arch, reqEditors...)
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
-	return ParseCreatePackageIndexResponse(rsp)
-}
 
-// ListPackagesByRepoWithResponse request returning *ListPackagesByRepoResponse
-func (c *ClientWithResponses) ListPackagesByRepoWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, reqEditors ...RequestEditorFn) (*ListPackagesByRepoResponse, error) {
-	rsp, err := c.ListPackagesByRepo(ctx, org, distro, version, repo, arch, reqEditors...)
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
-	return ParseListPackagesByRepoResponse(rsp)
-}
 
-// CreatePackageWithBodyWithResponse request with arbitrary body returning *CreatePackageResponse
-func (c *ClientWithResponses) CreatePackageWithBodyWithResponse(ctx context.Context, org string, distro string, version string, repo string, arch string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageResponse, error) {
-	rsp, err := c.CreatePackageWithBody(ctx, org, distro, version, repo, arch, contentType, body, reqEditors...)
+	// ------------- Path parameter "snapshot" -------------
+	var snapshot string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot", ctx.Param("snapshot"), &snapshot, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot: %s", err))
 	}
-	return ParseCreatePackageResponse(rsp)
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSnapshot(ctx, org, distro, version, repo, snapshot)
+	return err
 }
 
-// ParseGetHealthPingResponse parses an HTTP response from a GetHealthPingWithResponse call
-func ParseGetHealthPingResponse(rsp *http.Response) (*GetHealthPingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	response := &GetHealthPingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "version" -------------
+	var version string
 
-// ParseGetHealthReadyResponse parses an HTTP response from a GetHealthReadyWithResponse call
-func ParseGetHealthReadyResponse(rsp *http.Response) (*GetHealthReadyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
-	response := &GetHealthReadyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "snapshot" -------------
+	var snapshot string
 
-// ParseHeadHealthReadyResponse parses an HTTP response from a HeadHealthReadyWithResponse call
-func ParseHeadHealthReadyResponse(rsp *http.Response) (*HeadHealthReadyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot", ctx.Param("snapshot"), &snapshot, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot: %s", err))
 	}
 
-	response := &HeadHealthReadyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSnapshot(ctx, org, distro, version, repo, snapshot)
+	return err
 }
 
-// ParseListOrganizationsResponse parses an HTTP response from a ListOrganizationsWithResponse call
-func ParseListOrganizationsResponse(rsp *http.Response) (*ListOrganizationsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSnapshotFile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSnapshotFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	response := &ListOrganizationsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Organization
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "snapshot" -------------
+	var snapshot string
 
-// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
-func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot", ctx.Param("snapshot"), &snapshot, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot: %s", err))
 	}
 
-	response := &GetOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "file" -------------
+	var file string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "file", ctx.Param("file"), &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSnapshotFile(ctx, org, distro, version, repo, snapshot, arch, file)
+	return err
 }
 
-// ParseCreateRepoResponse parses an HTTP response from a CreateRepoWithResponse call
-func ParseCreateRepoResponse(rsp *http.Response) (*CreateRepoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CreateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	response := &CreateRepoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Repo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
 
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTransaction(ctx, org, distro, version, repo)
+	return err
 }
 
-// ParseListDistrosResponse parses an HTTP response from a ListDistrosWithResponse call
-func ParseListDistrosResponse(rsp *http.Response) (*ListDistrosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AbortTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) AbortTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	response := &ListDistrosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Distribution
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Path parameter "version" -------------
+	var version string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-// ParseGetOrgDistroResponse parses an HTTP response from a GetOrgDistroWithResponse call
-func ParseGetOrgDistroResponse(rsp *http.Response) (*GetOrgDistroResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	response := &GetOrgDistroResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AbortTransaction(ctx, org, distro, version, repo, id)
+	return err
+}
+
+// GetTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-// ParseListVersionsResponse parses an HTTP response from a ListVersionsWithResponse call
-func ParseListVersionsResponse(rsp *http.Response) (*ListVersionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	response := &ListVersionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RepoVersion
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransaction(ctx, org, distro, version, repo, id)
+	return err
 }
 
-// ParseListReposResponse parses an HTTP response from a ListReposWithResponse call
-func ParseListReposResponse(rsp *http.Response) (*ListReposResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CommitTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) CommitTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	response := &ListReposResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Repo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// ParseFindRepoByNameResponse parses an HTTP response from a FindRepoByNameWithResponse call
-func ParseFindRepoByNameResponse(rsp *http.Response) (*FindRepoByNameResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &FindRepoByNameResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommitTransaction(ctx, org, distro, version, repo, id)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+// UploadTransactionFile converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadTransactionFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-// ParseListArchesResponse parses an HTTP response from a ListArchesWithResponse call
-func ParseListArchesResponse(rsp *http.Response) (*ListArchesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
-	}
-
-	response := &ListArchesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Architecture
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Path parameter "version" -------------
+	var version string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-// ParseCreatePackageIndexResponse parses an HTTP response from a CreatePackageIndexWithResponse call
-func ParseCreatePackageIndexResponse(rsp *http.Response) (*CreatePackageIndexResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	response := &CreatePackageIndexResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Path parameter "arch" -------------
+	var arch string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "file" -------------
+	var file string
 
-// ParseListPackagesByRepoResponse parses an HTTP response from a ListPackagesByRepoWithResponse call
-func ParseListPackagesByRepoResponse(rsp *http.Response) (*ListPackagesByRepoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "file", ctx.Param("file"), &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
 	}
 
-	response := &ListPackagesByRepoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Package
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadTransactionFile(ctx, org, distro, version, repo, id, arch, file)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+// CreatePackageIndex converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePackageIndex(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-// ParseCreatePackageResponse parses an HTTP response from a CreatePackageWithResponse call
-func ParseCreatePackageResponse(rsp *http.Response) (*CreatePackageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
-	response := &CreatePackageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Package
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
-	return response, nil
-}
-
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+	// ------------- Path parameter "arch" -------------
+	var arch string
 
-	// (GET /health/ping)
-	GetHealthPing(ctx echo.Context) error
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
 
-	// (GET /health/ready)
-	GetHealthReady(ctx echo.Context) error
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// (HEAD /health/ready)
-	HeadHealthReady(ctx echo.Context) error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePackageIndexParams
+	// ------------- Optional query parameter "wait" -------------
 
-	// (GET /orgs)
-	ListOrganizations(ctx echo.Context) error
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
+	}
 
-	// (GET /{org})
-	GetOrganization(ctx echo.Context, org string) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePackageIndex(ctx, org, distro, version, repo, arch, params)
+	return err
+}
 
-	// (POST /{org})
-	CreateRepo(ctx echo.Context, org string) error
+// ListPackagesByRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPackagesByRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org" -------------
+	var org string
 
-	// (GET /{org}/distros)
-	ListDistros(ctx echo.Context, org string) error
+	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+	}
 
-	// (GET /{org}/{distro})
-	GetOrgDistro(ctx echo.Context, org string, distro string) error
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-	// (GET /{org}/{distro}/versions)
-	ListVersions(ctx echo.Context, org string, distro string) error
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
 
-	// (GET /{org}/{distro}/{version}/repos)
-	ListRepos(ctx echo.Context, org string, distro string, version string) error
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	// (GET /{org}/{distro}/{version}/{repo})
-	FindRepoByName(ctx echo.Context, org string, distro string, version string, repo string) error
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
 
-	// (GET /{org}/{distro}/{version}/{repo}/architectures)
-	ListArches(ctx echo.Context, org string, distro string, version string, repo string) error
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-	// (POST /{org}/{distro}/{version}/{repo}/{arch}/index)
-	CreatePackageIndex(ctx echo.Context, org string, distro string, version string, repo string, arch string) error
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
 
-	// (GET /{org}/{distro}/{version}/{repo}/{arch}/pkgs)
-	ListPackagesByRepo(ctx echo.Context, org string, distro string, version string, repo string, arch string) error
+	// ------------- Path parameter "arch" -------------
+	var arch string
 
-	// (POST /{org}/{distro}/{version}/{repo}/{arch}/pkgs)
-	CreatePackage(ctx echo.Context, org string, distro string, version string, repo string, arch string) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-// GetHealthPing converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHealthPing(ctx echo.Context) error {
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPackagesByRepoParams
+	// ------------- Optional query parameter "limit" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHealthPing(ctx)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// GetHealthReady converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHealthReady(ctx echo.Context) error {
-	var err error
+	// ------------- Optional query parameter "cursor" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHealthReady(ctx)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-// HeadHealthReady converts echo context to params.
-func (w *ServerInterfaceWrapper) HeadHealthReady(ctx echo.Context) error {
-	var err error
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HeadHealthReady(ctx)
-	return err
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
 
-// ListOrganizations converts echo context to params.
-func (w *ServerInterfaceWrapper) ListOrganizations(ctx echo.Context) error {
-	var err error
+		params.IfNoneMatch = &IfNoneMatch
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListOrganizations(ctx)
+	err = w.Handler.ListPackagesByRepo(ctx, org, distro, version, repo, arch, params)
 	return err
 }
 
-// GetOrganization converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrganization(ctx echo.Context) error {
+// CreatePackage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2170,33 +10424,47 @@ func (w *ServerInterfaceWrapper) GetOrganization(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "distro" -------------
+	var distro string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrganization(ctx, org)
-	return err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
 
-// CreateRepo converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateRepo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "org" -------------
-	var org string
+	// ------------- Path parameter "version" -------------
+	var version string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "org", ctx.Param("org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateRepo(ctx, org)
+	err = w.Handler.CreatePackage(ctx, org, distro, version, repo, arch)
 	return err
 }
 
-// ListDistros converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDistros(ctx echo.Context) error {
+// GetPackage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackage(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2206,15 +10474,55 @@ func (w *ServerInterfaceWrapper) ListDistros(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org: %s", err))
 	}
 
+	// ------------- Path parameter "distro" -------------
+	var distro string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "distro", ctx.Param("distro"), &distro, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDistros(ctx, org)
+	err = w.Handler.GetPackage(ctx, org, distro, version, repo, arch, name)
 	return err
 }
 
-// GetOrgDistro converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrgDistro(ctx echo.Context) error {
+// GetPackageVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackageVersion(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2232,15 +10540,55 @@ func (w *ServerInterfaceWrapper) GetOrgDistro(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "pkgVersion" -------------
+	var pkgVersion string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pkgVersion", ctx.Param("pkgVersion"), &pkgVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pkgVersion: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrgDistro(ctx, org, distro)
+	err = w.Handler.GetPackageVersion(ctx, org, distro, version, repo, arch, name, pkgVersion)
 	return err
 }
 
-// ListVersions converts echo context to params.
-func (w *ServerInterfaceWrapper) ListVersions(ctx echo.Context) error {
+// CreateUploadSession converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUploadSession(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2258,15 +10606,39 @@ func (w *ServerInterfaceWrapper) ListVersions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter distro: %s", err))
 	}
 
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListVersions(ctx, org, distro)
+	err = w.Handler.CreateUploadSession(ctx, org, distro, version, repo, arch)
 	return err
 }
 
-// ListRepos converts echo context to params.
-func (w *ServerInterfaceWrapper) ListRepos(ctx echo.Context) error {
+// DeleteUploadSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUploadSession(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2292,15 +10664,39 @@ func (w *ServerInterfaceWrapper) ListRepos(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", ctx.Param("repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListRepos(ctx, org, distro, version)
+	err = w.Handler.DeleteUploadSession(ctx, org, distro, version, repo, arch, id)
 	return err
 }
 
-// FindRepoByName converts echo context to params.
-func (w *ServerInterfaceWrapper) FindRepoByName(ctx echo.Context) error {
+// GetUploadSession converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUploadSession(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2334,15 +10730,31 @@ func (w *ServerInterfaceWrapper) FindRepoByName(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.FindRepoByName(ctx, org, distro, version, repo)
+	err = w.Handler.GetUploadSession(ctx, org, distro, version, repo, arch, id)
 	return err
 }
 
-// ListArches converts echo context to params.
-func (w *ServerInterfaceWrapper) ListArches(ctx echo.Context) error {
+// UploadChunk converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadChunk(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2376,15 +10788,40 @@ func (w *ServerInterfaceWrapper) ListArches(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
 	}
 
+	// ------------- Path parameter "arch" -------------
+	var arch string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "arch", ctx.Param("arch"), &arch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadChunkParams
+	// ------------- Required query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListArches(ctx, org, distro, version, repo)
+	err = w.Handler.UploadChunk(ctx, org, distro, version, repo, arch, id, params)
 	return err
 }
 
-// CreatePackageIndex converts echo context to params.
-func (w *ServerInterfaceWrapper) CreatePackageIndex(ctx echo.Context) error {
+// FinalizeUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) FinalizeUpload(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2426,15 +10863,23 @@ func (w *ServerInterfaceWrapper) CreatePackageIndex(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreatePackageIndex(ctx, org, distro, version, repo, arch)
+	err = w.Handler.FinalizeUpload(ctx, org, distro, version, repo, arch, id)
 	return err
 }
 
-// ListPackagesByRepo converts echo context to params.
-func (w *ServerInterfaceWrapper) ListPackagesByRepo(ctx echo.Context) error {
+// GetRepoFile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRepoFile(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2476,15 +10921,21 @@ func (w *ServerInterfaceWrapper) ListPackagesByRepo(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "file" -------------
+	var file string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file", ctx.Param("file"), &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListPackagesByRepo(ctx, org, distro, version, repo, arch)
+	err = w.Handler.GetRepoFile(ctx, org, distro, version, repo, arch, file)
 	return err
 }
 
-// CreatePackage converts echo context to params.
-func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
+// UploadRepoFile converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadRepoFile(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "org" -------------
 	var org string
@@ -2526,10 +10977,18 @@ func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter arch: %s", err))
 	}
 
+	// ------------- Path parameter "file" -------------
+	var file string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file", ctx.Param("file"), &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreatePackage(ctx, org, distro, version, repo, arch)
+	err = w.Handler.UploadRepoFile(ctx, org, distro, version, repo, arch, file)
 	return err
 }
 
@@ -2567,47 +11026,195 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/orgs", wrapper.ListOrganizations)
 	router.GET(baseURL+"/:org", wrapper.GetOrganization)
 	router.POST(baseURL+"/:org", wrapper.CreateRepo)
+	router.GET(baseURL+"/:org/audit", wrapper.ListAuditEntries)
 	router.GET(baseURL+"/:org/distros", wrapper.ListDistros)
+	router.GET(baseURL+"/:org/rdepends", wrapper.ListReverseDependencies)
+	router.GET(baseURL+"/:org/search", wrapper.SearchPackages)
 	router.GET(baseURL+"/:org/:distro", wrapper.GetOrgDistro)
+	router.GET(baseURL+"/:org/:distro/keyring", wrapper.GetKeyring)
+	router.POST(baseURL+"/:org/:distro/keyring", wrapper.RebuildKeyring)
+	router.GET(baseURL+"/:org/:distro/keys", wrapper.ListSigningKeys)
+	router.POST(baseURL+"/:org/:distro/keys", wrapper.CreateSigningKey)
+	router.GET(baseURL+"/:org/:distro/keys/:name", wrapper.GetSigningKey)
+	router.PATCH(baseURL+"/:org/:distro/keys/:name", wrapper.UpdateSigningKey)
+	router.GET(baseURL+"/:org/:distro/keys/:name/pub", wrapper.GetSigningKeyPublicKey)
 	router.GET(baseURL+"/:org/:distro/versions", wrapper.ListVersions)
 	router.GET(baseURL+"/:org/:distro/:version/repos", wrapper.ListRepos)
 	router.GET(baseURL+"/:org/:distro/:version/:repo", wrapper.FindRepoByName)
 	router.GET(baseURL+"/:org/:distro/:version/:repo/architectures", wrapper.ListArches)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/depcheck", wrapper.CheckRepoDependencies)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/mirror", wrapper.GetMirrorStatus)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/mirror/sync", wrapper.SyncMirror)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/pkgs", wrapper.CreateNoarchPackage)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/snapshots", wrapper.ListSnapshots)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/snapshots", wrapper.CreateSnapshot)
+	router.DELETE(baseURL+"/:org/:distro/:version/:repo/snapshots/:snapshot", wrapper.DeleteSnapshot)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/snapshots/:snapshot", wrapper.GetSnapshot)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/snapshots/:snapshot/:arch/:file", wrapper.GetSnapshotFile)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/transactions", wrapper.CreateTransaction)
+	router.DELETE(baseURL+"/:org/:distro/:version/:repo/transactions/:id", wrapper.AbortTransaction)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/transactions/:id", wrapper.GetTransaction)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/transactions/:id/commit", wrapper.CommitTransaction)
+	router.PUT(baseURL+"/:org/:distro/:version/:repo/transactions/:id/:arch/:file", wrapper.UploadTransactionFile)
 	router.POST(baseURL+"/:org/:distro/:version/:repo/:arch/index", wrapper.CreatePackageIndex)
 	router.GET(baseURL+"/:org/:distro/:version/:repo/:arch/pkgs", wrapper.ListPackagesByRepo)
 	router.POST(baseURL+"/:org/:distro/:version/:repo/:arch/pkgs", wrapper.CreatePackage)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/:arch/pkgs/:name", wrapper.GetPackage)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/:arch/pkgs/:name/:pkgVersion", wrapper.GetPackageVersion)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/:arch/uploads", wrapper.CreateUploadSession)
+	router.DELETE(baseURL+"/:org/:distro/:version/:repo/:arch/uploads/:id", wrapper.DeleteUploadSession)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/:arch/uploads/:id", wrapper.GetUploadSession)
+	router.PUT(baseURL+"/:org/:distro/:version/:repo/:arch/uploads/:id", wrapper.UploadChunk)
+	router.POST(baseURL+"/:org/:distro/:version/:repo/:arch/uploads/:id/finalize", wrapper.FinalizeUpload)
+	router.GET(baseURL+"/:org/:distro/:version/:repo/:arch/:file", wrapper.GetRepoFile)
+	router.PUT(baseURL+"/:org/:distro/:version/:repo/:arch/:file", wrapper.UploadRepoFile)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xazW7bOBB+FYK7h13AibLpJdBp059tAxRpkAK9BDmMpbHFViJZctTENfzuC1KSJVn0",
-	"T4K2sdHcZHM0M5zvmxlS5JwnqtBKoiTL4zm3SYYF+Mdzk2SCMKHSoPtNM4085paMkFO+GPHXwj2PSxJK",
-	"BgXeGKOMG9FGaTQk0CtOVOoVTpQpgHjMhaQXp3zUKBCScIrGaSjQWpiGzC9G3ODXUhhMeXxT6Wzlb5fK",
-	"1PgzJuR0vUWJBggvZIr3Q68sAZX+KUWbGKGraXENyReYIhPuNWbLJEFrWS29NDNWKkeQA79quZA/l3h3",
-	"jVoNPenZX3XndfuLqQmjDJlBrRgpBmnKjphUxCalTJwE5OyIfS4tsYkyDMGie6e02DreoiWhwKG9Sygw",
-	"YGioYGXiXlto2h/MFKT4Ds30Vube4VQADOdFLiw5j5w3llEGxMaYKzl1rlEmLFNmyv7yTykQsAJmbIws",
-	"RY0yRUlMSQYlZX/zEReEhbfzp8EJj/kfUZsPUZ0MUY/oi+WcwBiYrY+c7EROdScdCt0gTFcV7YYR2m6s",
-	"ZmwIY4O5Y0EwW7+hseFM3hXaMJ2hU0cCkDZw9sSYkBWUDuRdYeoVrABMj8qrn55Qu2WS+8vLNiiti/+n",
-	"TShaTEojaPbRRawCY4xg0JyXlC3Lv69n/u/Wt4xI84XTIeREVVVcEiTkHrEAkbel0v4LlINNclWmx/ez",
-	"77wJBT93/79y/zckZYRQ8BEvTV5bsXEUNYqOVxStgsjPry48EgHFdbxykaCsGN84oSHJkJ0enwzs3t3d",
-	"HYMfPlZmGtXv2uj9xas3lx/fHJ0enxxnVOSeXWgK+2HyEc03kWBHSd/niJRvE4JyJ1SnNXNQsfOriw6i",
-	"MXfqT47GSPCPs6A0StCCx/yFG+AjroEyj1qUIeSURdohG8/5FD0QLu18jblIeczfIr3zYldOyjHIauXm",
-	"40RPT04aFFH6lwnvKdI5CNkuBDy691Bo77xWXs8qrwaoOK+YF+6Sjsc3t+5347tBSGfbnb/2Yj/Ae1Mr",
-	"2uq+F2TKuMwfzmDEM4R06PA7hHQ/PXYxV2ZqO7EOl+Bul3Kk7U/wvbD0YUViyxRB61wkXjr6bNXKRHcq",
-	"6r3lwqCoD0PRn4MfnkCZ04M82+RQtagNWC4l3mtMCFOGtUwAiLky08VaJFxxZTBWJTGQW/B4iz04fHkw",
-	"UCChsTy+CS2dNixJRLXWpayt1spUVaNpRWRKHHWitErL2yfkQ9MEg4Rgvmf5Ma1sIOyvDAIhk3jXtI1+",
-	"qKvx62poH6L8tURLL1VVP38IrZvdSCCC171lf9/Txa/AvHJse+77HcFT53yb5ZHfzKj1dfcaqTSSwXJL",
-	"09v9+IVNVQWCtfh1rf33yPvNe7D958K8IsNiGxm6HaDHh010qFpBRYin58Nom8nutMIm02Yqh8HC9d1n",
-	"X2kY1buO7YvCpWCoBn1qB59J9zPbX7Ot36HyLQHbQ9bNa+cWUZUY68jnuNXbyVtBygjsNsUlgRjIdBXe",
-	"IVWvvcHflKdBqzUWuxtuvlTsZYYc8qKgkxdz5+O2VUL1XbRaK0yMKhiE9y3/CZm62LycXVYfEZ/J/0vJ",
-	"P1r3mb45SZkgJVnYXg3ofmfa5oUPa/w5hJSLBucUD+hMs5Xji0d3qXOTZPjcpvYvU3OlvnhYm7OJQ8ra",
-	"zSdjwxTqp8IhJO/cubyIxPJs/zl92kx4BI8PKocGhh0ZHmHYvfbQ5N34QRlY//aIkOtWatUL9elgdUPl",
-	"VxSG/p2YXfp6f0IH1eDrGqG/TO3W9XX7TbahDBuDxZQpWeWuqsjlmnqbK8N+XiNqX86uG1b/bEybmyM7",
-	"1Pnl3PYGx+eFz3Pl3rfKvVPN5puO5YoyJ6HBUDRRpjhKgaCfP/0bU7q9+7W8IzkWEsxsl7tj62v2U57h",
-	"Pbwq7VFz6R/k9y9N3dw6Mlk035qStdNdpgi04I7/rXQcRblKIM+Upfjs7OyML24X/wcAAP//VHxkSaEr",
-	"AAA=",
+	"H4sIAAAAAAAC/+y9e28bubIg/lUI/Q7g+KItOZnM/GYMLLCZZDI3OHMy2WTm4GKPswuqu6TmcYvsS7Jt",
+	"K4G/+6KKZD/UbD0SW1Em+iuxmt0sFuvFevHjKFWLUkmQ1owuPo5y4Blo+u8vf/A5/puBSbUorVBydDES",
+	"GUgrZgIMs7kw7Bq0EUoyNWM2B1YIY4WcJ8yAzJiwbMrTKyYkezU7e60knP2D2zQfJSOT5rDg+H27LGF0",
+	"MTJWCzkf3d0lo/86ew239ux5pY3SfRBKbvzk3NCkKQ1kJdd8ARY0s4rNwdIzCbeWlXwOCZPKMgOWKelA",
+	"5cY9WQvNXTKqv0toSQegwk92AGcOl2ym1YImLDVcC1WZMKnA1/67Ar0cJSPJFziv//p6/IgZotJhsgcH",
+	"bpubkzczajClkgYSxtl350+ZwJ9spSVkTHR2juXcyBPL0pzLOWQBTLeWBs5dtrMQC2H7gC74rVhUCyar",
+	"xRQ0EhBIq4mwlIcuYXANemlzBKwN800O0pGAIGgN2AGEusnbAPp5RxePz8/Pk9FCSP9nEmAX0sIctNt9",
+	"9yJt/TOd5sJCaisNkaUmo2dVJuwv0uolPi61KkFbAfQy12kefSktBEj7qow+zISxWkUfzUThviwsLOg/",
+	"f9MwG12M/r9Jw9MTD/6EQHspCsBX/be41nyJfy/A5iqLzoJL4G7HYhRfP2YiC5QuDDMlpAmD8XzM/iwL",
+	"xbO3UCqaPYlMoefxqSubqgVhGiTu0L9GpkpTMGaUjGZcFLgN7yMfLLmNo1pDGcelsdxWJr7E3NqSuQHE",
+	"KBr+uwJj2VzZUZ9kkpEVDuaZ0gtuRxejjFs4o18jsFp1BfJVFp/aWK5tEK008sQwk/Mn3/+QsJYkFpbd",
+	"CJuryrK5uCZmsYzf8OXgjK/5AuJzIt90pmRIaJ2V88rmOHfKLfKisHlsHq8Z4jIBPyU0ZLipHjUNpdUE",
+	"6bfSkUiLU+oNa4ikoQM1/TektmZHorrtuVF6xPQeOLTHH4kP3R0X0v7wNEIcKwuXfADwF1CCzECmS+Qb",
+	"bXeRJkrOCpHa7SXDG62uRQb6uX8zJiDSZeqFTZdg5lpVpUFyKXl6xedkFXDLMloAalrgac6UzUl51PDU",
+	"/+lT58rMq3+HefqwNHqkhiXNIb0iJdZn00oaboWZCci2RtWfzTvNFvWhXNlm2qsW5N2521tWIzpKFAKx",
+	"NK1snKmS0S9aK90nlVRlPer87kkUJwswBm2TjSxL32zGx8B9KSQvxAdw8r8PVsNPEblHz4IUuslVAWFT",
+	"E5bDLQOJEHgBYUHji//nX+dnP/Gz2fuPPzy9+9so2bAEP38M9F9BoiiCVzKD2wjkA8rCQ8gEvsa8qmK1",
+	"pPLTTJUqgMs+PG5cDB6CA7I37vs7CINpJYrsj530EbGMqRb95eFW/K/HaFPOxC1kLIxsDFxaeOyjThzs",
+	"yPmd2Xczjdyjfw4qIGc8yagObOu/MS+vnPoT0mvAUsUWKKSxvChezXZbon8NsnfbahC0plOQJq6jFlxI",
+	"y4UEvVa3DS/Yk3DcShNzEUdm6TTIjvs7bIxtj4xKF9FPbG17EEqa8TVVrdKQBzdxvBZj0n8IrZXGI8K7",
+	"Wj5syaaZupEoJCEbFCqGNYPYdNkcYL2YmVUFM0uZRrEEQTF0P32Tt7+zlClDoxqyhC2EMWRFzsi0VLqj",
+	"RBvA8c13DoDtJQy9hKBGAAK5CpGQwuQI000OaEUwpekg34Zru3mHLYcax57Lq9JYDXzhJTm3u6Fbw0Jd",
+	"r91LP2LnjTRiLiH7eRkXzjXYV7Bs5DG74Ya5FwdM9Zi5Mkzh66h7h/Noj12i4tGCvuZFf7m5umFqZkEy",
+	"YfFItJQp0gg3jLNfFcsqfyh9REfQx/n54tycxogC38T/xmiRyI07OhSG6UpKZAot5rllUt1EdHoyCpuw",
+	"YYtQmpwY9ufb3xLaKsQeTsIzZHCr6EeQ2cbdqudr1pKEvYht4mu4eesFb3f/VtRt58/Ri+avoCpwBQgo",
+	"zzJ2Riw5q2SKI3jBzti/K2PZTGkG3JB6qUyUK+M66XVLJ7Um2oiNwRPVa7h5J+a4f3+HmHMmteKaWw/J",
+	"jFeFHV3MeGEg6XmtrsA7Fm+I0ehVYMZqToSBx272yLslNUgbRuBgZHvn31KWWyHnp1EimgprOqA8Pf/p",
+	"h6TxXP34+KcnLc/Vk/OnP0YPm1E0SF6aXNl1HNwnXPcMITf+/YTxovB7tHBORHLHBeXRPu59tq0XqGTB",
+	"b38DObf56OLxkx+71v+zs//Nzz6cn/30vvnv+P+evf+Pv30W2bjzyzswwaDoIm3m3Qt9lHlpf2KcBekt",
+	"jUFrZ90HcEzitPGJYVdS3UhWlWh4SztKNhtKK8slmKPLVbjPa88ZmwikgZsUT5BnO1HDZ9mpn276rZGa",
+	"v+s5l+JD7QhdEZ2tk/kAetC7jsCjMPM+kikUSs6drBeGKT1HsSEMy7jlbMGXbArekYJSREnyu52Oku0U",
+	"bMdd8ElIVu1Fx1ioh6ZByvmsHdVQoBK5B0P//TDML8ByUcT5ewdfmvvYkJ+9bPCz7isr53188Wou5Iy0",
+	"Ns8y4bTsmw6gnyhsiTo1v2HjN3//9dXrl7+zVEmL4CSosQxLuWQ5vwa2qAorygLYNS8qMOyRo82EheNf",
+	"wsCmp6MIjt18EdbwB2DmBwQDPEiQR+NSw5kflLBxqYw9q8q55hnUs22/cLSCua00eBtgmBqDBd0WZS0b",
+	"unNCao/z0SgauVHrNCQftjcEdRqMraHYuGO7EPLqD67nEIm4Wfod12iWCxxoGJcZy7nO6K8Y8y2897D7",
+	"JZVaXrASNOFBSYYWi7e2z///778/3d7UQw9/QHvb1bJGIOzgIHC/NAGkmYtBZYJirA4Lo2QUUICP4Fqk",
+	"QFsxU6P3mzbRaxECyWPLvxLdulVf+5aSsk1jjk51zXYUNo1GevwIbdZ+0KsjZEP/QrBVtuWsOEqa2WOY",
+	"iB9CeCvCGoE6aNHOMEcwFB0u1bbasRPK3WyQbncaevBj0GhLavRjg3Icwv86B+lbjLwbaIU5dvBndV7q",
+	"E5577gnXUV8BGctBw4D32EYSHR4TXjOhIbWNnWQS9oQe2ByE7vwONu5V+SxH8mC8cNC9eb3ma9eCr2d+",
+	"QlYIHjlzu4Xt5EG8nWEHOhvrYI1R1jvAt96CqQob8RO13XBbsWrfGurFCJXlxVYBQULfAnNW0PsGM6XR",
+	"NF8IO1GzmQHLbkAD42VZiGjYcAWfbuKWazGKjzV+h3DQ7xNlqgGj69u7VWdCzkGXWsiI5u8G1F788jZE",
+	"0FhZTQuRosGzvVhaTRO4gqXzYpWavCj4AxOGXVbn59+lOJL+B2NteFRPEQxRoyxYBe3paDg6hVvAs0ex",
+	"ycZlNT1NmMsbMKysrPdSSDYBm054eTVBIzcGVBPlW0eczeY2bsxrXojspVaLNR5uBBmNypnQBikyA+8m",
+	"WnG+nxgmURIH65NLlw21tdebgPlTWlFsAY0Gi6S9AkNICZsCyC3nHTCSQii02fD1DPNuINLqPWpnhBXj",
+	"/Nx4/rCoQsiNatlCGcuUhEsZfG3sjPHCqNWXyGjt5OchOrIKV8K499QpeSmFrOetSTBh08qyTEFt+Ndb",
+	"xJZgL6VHKTsbGMQlegU1uA8Zy5emReBGMbj1iXn16YWSLsVseYm7EQxbB9ooGYXVjpKRkM2vDoxoulSD",
+	"7z/LzDtBhyLeu/HC9iHubbySW2mL8CE08KKZLLvK1W1dk1txQJh9rbOps4TtrS6vhJ6rStrWgJZOGY6A",
+	"dY4D/gDWOHoLmFmmKjK90QQ321rZYSWDunttpoxbyToMDfqbgld2H1lc/kjpv+y/E4P6D82l4Wnchwi3",
+	"pYieeWohbZvXScRNlbYufVcqJ0ugMMByXpYgyVUv7NZqYjc3V2slQ64ukW1mCoGMEBYeYNiAuR3T+QYJ",
+	"YeM5GzXvkMv+HohoB9el5wpPZz0b3rse1uQzxdPWIhHAnY9tgfLqDJDhuPvnuW8bb1Dr8NLAG131+sDN",
+	"ZoYz7lWXeO4C9sRspK9ZmlfkQNPaadjt+SyKCTGQfk3HkngAfFGlOdpmHoYsaQoeCDiXPmzCuXoLkvxU",
+	"EUiM7OnTQ9wwdn9vcCZIKy3s8h1KFH8WAq5BP6vcWZ9EDYVF6ecGhtza0mXmB494qqTlzpcGC3Li15ru",
+	"f3JbcJMWqsrGt8sPTVnAM/z9Of7eHK1dFJ1SimgWczGZhA+NVz7Uc6M/e/OK3A6RD/uzdCtxKwBR8jQH",
+	"9mR83pv35uZmzOnxWOn5xL9rJr+9ev7L63e/nD0Zn49zuyhI9IJemN9n70CT57L5SBfmiVXEn8IiCQYv",
+	"MkNHEHv25lWLtS5G+PnzsylY/tgXAUheitHF6Dt84POyadcmOfDC5pPSZ1F4x3OdzI2p7aNfwf4nDXvj",
+	"DNNQjkJfeHJ+HnYRvOUCt3ZSFlzImhK4Y1m+KAn4Usl5QxLtYp0V5zIJJyXnHaIbXfzrPf4dYNfAs+Vm",
+	"4N/SsHuAXvsPbQSfBvqMp/4KEqrK6QP8n8Czw4QYca703LRwHXfwtkOPZpSsLPA3YezvKyPaRVr/ilsv",
+	"zZCJqwq6SzYO9PVYW4xsF2Xdvd+Ic/IxpQT+5N9GrWB+KyusjYKIad3bm1WkRmr9YtP5YRMaE6vOW/dS",
+	"dzAB9d350+EYua9AI0e6L0FjRsjU5dwgCMyAtP2KwrukyZbZAdHr8Ovy6SOIrCTclpCi9Q1+TITQPyo9",
+	"vxukdFReaMNXlnG5gd5/hQ6596l9vXtuJY4vQtSt0YauvKbR6FZXsK6m70uSd21ExOibIVrds1KZCNqf",
+	"0wGckre8Wu6i2j1/6x4dApap4upnlS13QvA6vIYMwAgG33ZS7bqQ3u1jzx1gm0UZ7p350jzfcPmEY6XZ",
+	"IK+jvnJZnjiMFWpOQUxi+xPDFpX3T/rdNglTRQbGOu9wVP3VlaYCzJen1GR1SiWLZV3Oyy0aBHxGldno",
+	"fveHo1i5Lsn6Trnudu7mtQD4QM+muSvykt/z3C4UKUy3UvZRpDT2dACsdl3kDluAdO8Lpg3SGvFSwvDn",
+	"grmcYcOUBH+cL0GzQkgggOG2VLp2IvdB8nhpw1MzIk3cckz7P2neWDrFXnRJqzA75oNsz3B7JrP+LBsN",
+	"X8faftcPSDK5wO6wxf2WKITxOkOxk8xI1OAEVVQMvfBfPwQJ9C2Y/OuTOof05NHUfyDm0q3CxvWavw6u",
+	"3OTKtHI2BFUDGVVcAxlem7O8+ky4mqpzmCbB+oV5jWjURSGmM6XGRo0fD2nEOsvp8+yTQqmrOnmsLfYG",
+	"pq2TZT5ropWGOVvP2+Tm7DA9uYkxxFzANRRUqN9kRCHBzVRRqJuEPWYEabAXellVQ6D5xKCIHfA4aXda",
+	"2dBn5f1+DherKW1bSVB6qcOxBySBDITQV1T+uFysboqyy3toTiA9meJeetO0KzjI04UJS3tg5m3Ps1fe",
+	"7c0e8lsj0/hHn/P1dk7twCw+EPmpQn9eqKmX8+Xyu7P/2CDed5km6JLWFF1VghonXWQXM6WGpm3FMHfB",
+	"I5XFs0dGVTqtuey0WfHAbO693eZKuUH+NSCNcEWH1dSNDhTZagAQn7YzYIepTclTYAZQFKAoCqeFwBGp",
+	"klQBSWolpJNT2YhVLuHSb8vlyKXn/Y/H4yc+QfDJ5WhoT/zn36J1uBvAMwEFFdIapa1PoKaMVDZdDkyG",
+	"IwfOtJ4kw5m2F4P3/Nf023i/hZ+AIHOadliAKJ2BHoCKm7QFlPsLp9hq9n77tYCguv3a1i3VGqVPHdV2",
+	"aLCWDCfttsAxV6Icwk8IN0egaU9+/gBWx9rUp04WdESve9mrw4hk9PT8/OHNCXf6WgjLhAk5XRrZK2Eu",
+	"ab5mafqVUbYsJZEekNXz0anVu01ejXaQpaOt1/k1XLTlRbAXDs34WZ0yZoR0p6xNnwML8Aw4M4YDPIfm",
+	"9Q9kiDnkupv/sFIw5Hu30IY5paf0nP4DZ1RlWXe3uslFmjNf8mh6O3xiWknvhj3iJdeWOjA1qcZKgjll",
+	"QlrVzXIfs1eYT64B1ZSlHpqUWs5Dvr7PTiVbiRwyoXvV+FK+8NnLEjAL3XUGHF/KGPf83WPjyDtb8I5K",
+	"LdizpmdIJPgwFZLrrbIvfC6/pqyXJuf26fnTNRDco2LpiNiQ705WIPogkAZrLRPAbNUWMEeXS7AHFs0f",
+	"Cia/cXn6rkltuxjF15918YGlto73MhbZqITeQhxdSuTnJmqFxRmg23UvvLJqwa1IeVEsHUa5r11KVSkg",
+	"Y0Jic6GcyyzGpG8dGEdGXWHUAW81idrVLZDdXfRS9cDY7UCV5RYOc+OKSRzuyVrrLDdhQqZFldVD3KFT",
+	"Q6iw8bU1rUocx39ORTpRZFRdGpZyyQxgr0luqSWHrowds221HoLdlL+Y0T7Mp2a+bfyYbXzu7bDhTw7U",
+	"20TpzgYenoj/RkVgXLWFFqiMU67U23fP2hwZYcgx+yOHtg4U7To2Skp3Xc0upeuB022YtcqK1PiamNB3",
+	"s/basGZp0WnIwYSNsaXL5moxyoNlVLWZ8e7u7iEdC52ZYmrBdyY7BC4nc4/L4EIgwqAigwPVS5OPyEt3",
+	"m7MlWswwoCViJ6MVSvxiFHIg1LE3Y0kqZrBYxq/7qHe+tN5JtmgpEJ9s59g/qrj4TSnPycfB/EULtnIx",
+	"0S5r/4NfCSoDb7V69E0cWxXjxbLd5NGqpuacjMwQcuUaOkprqmzOKOvQbW5Bvht/0QmuzinV5tOXMuXu",
+	"tBxG+PhBsRyzV36WUqRX2B0QP5k2S3QqWCzor+WJBjb3Oj56RHSl4A+uOnu153d3d6u7+2W1aUVwZYeq",
+	"UU3dduJLSNKn5z/t56jrCdn1V+OYswIhi5Y4AZnUdnjl9MBtjElZTTc7jHnLpHb1nQalJDVKa7c1We1o",
+	"8klGyZu6LcdRLe1BLe0g1G7PSlicheLhHdKSEeg3v/wj1u7naAAeVKVYIyR8AHZzlWQ9MOaO+mfz8Fvl",
+	"5W8hI7zdx28LN2CLZI454Q+r6z96XN9NXNx6rcu73SvACKvq2qGV4lCKO6zwSywjvFTmqMRbs26Rudmd",
+	"uJ3bdRQ5n1GkeRQ0+xM0HxHlm7yXLpHY5Ub5e2KjpdAvhaTaxJ+Xr31D36M02bM0iTcoDxeizMDdvRuZ",
+	"z2/ogSV8rQiO9Yle9Z3FXwPLTXqNq3dQ9cuVftafrPafuZ6CR049NE6lArCZ0mHvzT1x7TdicKxv1t5n",
+	"4i4zHg2QvUvDDEq6HXRQED7Hp93u5cLVpK12InXGCXuEAlBYw1IlZ2JeYeij5HS9Fj43p8RerXt1/YdN",
+	"QoLHuIBLfYVJfTeBE6wBhiXz9+/2EgkQXlRfB15k+60LWkd19yZcIyVzqafce6qY20+i/ep14lvIUCWd",
+	"haIttQbhKyL44EXQQoRLT+OFsUuZdqK+joLoegB6k3qmdK5rdLbamD1zY5EA/FhKpUfpdCmdeMKorOvM",
+	"6UeMl3xRDKTLd67VfMBQZ2eeCKb9WvYcUKwvT/GhPY+wL05jR9necRiv3ELz0PJ8/VS7n263lxgT468m",
+	"Pm7/X2f7h7JcSQs0UtwlkppG7EtsjaE5XQRscy7ZDRdkuaOxKei+On9Dca+HwlKmTuBushLxk3XBCBIf",
+	"uXbo2msqAwOeIUoy5RNhfXf+KU+v5lpVMgtIWjE98Lvxslh/r+3qtbMPWg67k/JJ6mZxHiNiRph31/CB",
+	"PQTltLfMF48ZNDcK11033PP8NZhh5dXcHKXptyFNn2WYaCTpDuGmq7ry5XF1IxpiqJybhJmc4zeZsUrj",
+	"2CnYG3DN9xdoU2u4lKUyRkwLSHz9XEhaZMDTnD564u/y6UvG4ZKA7kXH61IbncuAazvBesyzjFveZafo",
+	"7Wbb1W9u4QnvY/MherOuLW7ooGpASAX4QlWcvw1a2O4l0PtsctC9ktXhsa4+JRrsVcx5qJdg9yrhV4+T",
+	"dKPuLdV1XgttK17QkATRGWC2eA19Rf1DzVehCMJtQhuCI+4gjleeh/EJ2WWqsv5Gx7Cv4SKiSCFePdVe",
+	"yvD8bFsV4dWQHY+3R428h/ONp7dG5nESysLg0ebCH2FQd6Lg9mXsQW5KJmzi7671qpbqES5lIGP8jgGN",
+	"F+Nwy7YXAZOP4b93NLHAK/dKeyldLQTdephBARayhCpyEbL2xVaN1LQGitma0j8/zwMW/tXsv+fChc68",
+	"8SJA0xJNe9G7U57VkxIzh2oFMmKCHKfqhQxu96lkNZw0ByjeAElnfneVFTLfV6VJW2zkWB85JnZbNf7e",
+	"WnTSBNikYoq8GzVC3H1SEPgvOEV6/OW+2uGvbVopNIjnNY/vPQu8zRdfWAtvqDIN2OKFkvNArN0AqbDR",
+	"oo7BjdmfCOqKn29zf49W1l/GytqIS9PwXGQ+0zVF7jFwEVMIk4+ocu8mH7Fm6G6bOjMcyB41DcjxLrs3",
+	"f3/1+sUv/zW2XI/nH05DumijSIzqrPzEsD/f/kYdHObqUnZbkLVT27fvrNKSZS/dBYNHljqy1GewVG9O",
+	"sk03rs1ncnzm2pDJmotlI9P4SzS/mm5zM3/97xfR7yijAgCHXdLXk9mty5yP8ZFvxBvzewnSZRWRym2R",
+	"gO+nhFONWWi878dRMz+6zJs9sor81C2YT8yldG7r09D97FpQwIS1nCmpWiyE9e4UkeauDNjkro3Fgpp1",
+	"c8uUTMmFfymbOIuJBFpw3Ji1LuX27dfQkV43c2zNqdB5kzF+KYNxEZbm/EtPnrJcVdodPP295sM+nda8",
+	"/dPN43sTAO1p1vhYbHvYMWhwv66OtpScfBTZWj/HMyQcNCWbl5L6gvIeL7Xbk/UIjT61ls4G3BttnqbI",
+	"lyPmvWvIDg4oV4W567izvRJpGx0C7+HDragFw8G7X9rgh8hmOSidjWIzrmMHmLWUdL5PidWTVgdDk0c/",
+	"zdH+esBDZUcSZfGZRHb/vpmeBps48Xe0+Y809yk0t1Ur8pjJ09dmrVwqhNab9rPG3mfCXkqrqjTHTnSv",
+	"fR87VOThRR8ecndJqQazralOzEq0ImrYE08cjpqsTZQDVpj7PWnEjhB0Fl0o3Vwds2LvhXjrodl9nya4",
+	"ex71o/w+ym+Rff48vSsa5woMsw/qiPZTnRgX97lfh3RZRTTUn2VoaFgnGPXVkmurqvlNuAufTVW2TJwX",
+	"i9rIkdap+yBi63BuLmX3JnU2raxTSk0HcirRi8ioWiqF69hDJ0n8vvOtkbYkNPE5FxLpruApmAFl5j7T",
+	"0jIvGxRuTkC6dw/9mJdXe00Y3u0YmvSyGly68F5zhWseqAs9EGu1WkMarJ8QFe79dp2vzrHz9PF3+87z",
+	"ZlMxn4dKLXxEuYnatYv9Ohye3sZw6XFHE6NvYuzYUeVr6+YSj0zvOPHOFsLgqdIFXDo62xfaDPQMcy/4",
+	"CBY1JN+p/NF93ipscN6cMf86VZDhVhWHmYjc6OK53QHriwWW/F39t2SFhQhgqhZgXDpQKJb9muRrqEpc",
+	"2yWP15cc1w6MKTd0v6KTe8rhCq3DRs70y1FCPPdn6r7R54dvoYPTm8Zc2lQjUyO7pv5jA6djsONoVfyl",
+	"rYqt7IlDrtP9ggW6a0pzm1aTB2BI/CUzVFoGxXZ3hxmWgeWiMP5eclIn3IKx7RZqvLkVtrnnMngqqIQP",
+	"0cFFpPf/r2Db/PKwRPeC1rKO9Pxq9+YoCfOiWp7hwSAcFRw51nVnT57s1z1BLiZhmNWVTMMt2xlf8Pkx",
+	"/+FoEnwjJsHacE5ZS617uV/n06T35GN5Nff3aewqyjkzJaRiJtJ7F+X/bJHXUaIfJfpRoh8l+rcm0Tdl",
+	"HgS+fNQVs2eaaSiAGziNA9II/AdTMOFEc4zo/KWTRh6oknC4vQvdks6ZBlMtONX8uCSLrtnRZntmlcI4",
+	"KXukNBoeEkIBj1JsVvCr5SndX2vA6VnXBpqcLmP2DrpJ8DggzSt55duru8yH5/hL4htl5+qGLTBwnXOq",
+	"VNLiOmQN/grWvfAODBGDa/rI2YyLotJA111K9lJIXogP4MaOmR/dLTm6lFRg5KDp1xRpwDt719QUdQB5",
+	"uGYx3Wm26hhzfwVNkcnjJU2eiEwY+cUTMb46t9Ux+eEzfGkej1vUe3GZqVZFZeL4HBU/No3Chj9e3gyU",
+	"5rgeNn3e36bSq8skVOzlpczec4K6oBxebc26IqtaP6hZs5G+CRHtXeIVAmReuNPt6BRZo/CzsIap2cxA",
+	"tA3Ohr09369wjQnWI50cT6xH0/hec5tXRPM9lt5Ug12PnWyi+nw//5g9d6YxtZd1OU0hIUDpDHTi5RZK",
+	"NGp27G6VURKYsVzjbSJcOlno3m7LytY6c95UWnihiZeUeIjQSJ6Rk7LEwwImTJNpdSmx5SJ18BGWOvZM",
+	"oSNY6xxrB+VwpjMtc2OaFy3QO+7qYCmu18HpVjyQxFXL9+HdqoO4Qtofno6S0UJIsagWo4vzOnorpIU5",
+	"6JDjtYds7P0FgT9B/7Qyr90e7D/v2s2bqqrIXN8IhmR8eIpxL4ePWhogKtrn5lUz9kscMG5wj3CD1h4z",
+	"fMZ8eCQ+gB/TGMnE6N4F8LWeSiYz75M4OvSOVsvhWy1R3+FLd8NMba5cNIrA+czIfweZq3kyLlHF5PzJ",
+	"9z+4kYUASUISUmuSpjjrUnaqszoZXPUo0/ZPopUTrgioUU2tphtsmEvpj9fOTXjDdWb8q4VReEOMgx/S",
+	"K1Mtas/MAlMuvUCPVvAkl7LJ2mxZVFY5UeXWj0+j7Qm73skH8hyuTLJn02Ln/LK9GQ9hr0VWb3Wybq+/",
+	"UcNibRF3XeQWWC3nNGZOXYeLIP1Il4cbmL5SFf4gvVCdL9ioVke2Vg9UZqzmYp7b9c1QX1L7N+dndh90",
+	"ySF0evNt3VKOfRjYEugXd/k7ZATGpQxFGEndp6ju1aBhpsGEoc3dZtTsjepqVZG1LToC48SwP/74bXwp",
+	"d+jTGspvR99gS0pHMUonvpVfXTHjJZE/+dbYp+05RTC/P3+yx9iKMC1aorpmpCNfVNUlEOd1PbDemkcv",
+	"4dHePuBWvNt1Phhsc9C+atLdHhyqGlCsLMbsFcprx59oaQft4qxVd4ka3ZRecm08g+echL9yAuiGL0+9",
+	"0+/EQTEFa0GzNFciBZJeUzGvO3WaMftZZQKG4osnhp0t+O2ZsybOFlMfb/83pMFEYJw9ffzdsP+woza+",
+	"jRYJh2pSf2YfhGOE/muO0HdV7cfRFLgG/ayyOWne98nIAez0bqWL0cUot7Y0F5NJLS24LbhJC1Vl49vl",
+	"hwkvBRWkNqMvJpNCpbzIlbEXP/7444+ju/d3/28ARFVHPIYCAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// an .apk is up to three concatenated gzip'd tar streams
+//  * the signature (.SIGN.RSA.<keyname>) - only present in signed packages
+//  * the control data (.PKGINFO and any install scripts)
+//  * the actual package contents
+
+const signaturePrefix = ".SIGN.RSA."
+
+// errDamagedPackage - the .apk is cut short, or its streams aren't what an .apk is made of
+var errDamagedPackage = errors.New("the package is truncated or damaged")
+
+// damaged - mark errors that come from the package's bytes, rather than from reading them
+func damaged(err error) error {
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, gzip.ErrHeader) || errors.Is(err, gzip.ErrChecksum) || errors.Is(err, tar.ErrHeader) {
+		return fmt.Errorf("%w: %w", errDamagedPackage, err)
+	}
+	return err
+}
+
+// apkFileEntry - a single entry from the data tarball
+type apkFileEntry struct {
+	name       string
+	size       int64
+	mode       int64
+	typ        PackageFileType
+	linkTarget string
+}
+
+// apkContents - everything we can learn about a package by reading the .apk itself
+type apkContents struct {
+	signatureKey string
+	pkginfo      map[string][]string
+	scripts      []string
+	files        []apkFileEntry
+}
+
+func tarEntryType(flag byte) PackageFileType {
+	switch flag {
+	case tar.TypeDir:
+		return Dir
+	case tar.TypeSymlink:
+		return Symlink
+	case tar.TypeLink:
+		return Hardlink
+	case tar.TypeChar, tar.TypeBlock:
+		return Device
+	case tar.TypeFifo:
+		return Fifo
+	}
+	return File
+}
+
+// parsePkginfo - parse the "key = value" lines of a .PKGINFO, keys can be repeated (depend, provides, etc)
+func parsePkginfo(r io.Reader) (map[string][]string, error) {
+	result := make(map[string][]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		k = strings.TrimSpace(k)
+		result[k] = append(result[k], strings.TrimSpace(v))
+	}
+	return result, s.Err()
+}
+
+// inspectApk - read through an .apk and collect the signature key name, .PKGINFO, install scripts
+// and the list of files it installs
+func inspectApk(r io.Reader) (*apkContents, error) {
+	contents := &apkContents{}
+	// gzip needs a ByteReader so it doesn't read past the end of each stream
+	br := bufio.NewReader(r)
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, damaged(fmt.Errorf("not a gzip stream: %w", err))
+	}
+
+	// the data stream always comes after the control stream, a package that ends with the control
+	// stream has been cut short even though every stream in it is whole
+	dataStream := false
+	for {
+		zr.Multistream(false)
+		dataStream = contents.pkginfo != nil
+		tr := tar.NewReader(zr)
+		for {
+			hdr, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				// the signature and control streams don't have end of archive markers, but they do
+				// end on a header boundary, anything else is a truncated package
+				break
+			}
+			if err != nil {
+				return nil, damaged(err)
+			}
+
+			switch {
+			case strings.HasPrefix(hdr.Name, signaturePrefix):
+				contents.signatureKey = strings.TrimPrefix(hdr.Name, signaturePrefix)
+			case hdr.Name == ".PKGINFO":
+				contents.pkginfo, err = parsePkginfo(tr)
+				if err != nil {
+					return nil, damaged(err)
+				}
+			case strings.HasPrefix(hdr.Name, ".") && !strings.Contains(hdr.Name, "/"):
+				// install scripts live next to the .PKGINFO (.pre-install, .post-upgrade, .trigger, etc)
+				// nothing in the data tarball is a top level dotfile
+				contents.scripts = append(contents.scripts, hdr.Name)
+			default:
+				contents.files = append(contents.files, apkFileEntry{
+					name:       hdr.Name,
+					size:       hdr.Size,
+					mode:       hdr.Mode,
+					typ:        tarEntryType(hdr.Typeflag),
+					linkTarget: hdr.Linkname,
+				})
+			}
+		}
+
+		// skip whatever padding is left in this stream and move on to the next one
+		_, err = io.Copy(io.Discard, zr)
+		if err != nil {
+			return nil, damaged(err)
+		}
+		err = zr.Reset(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, damaged(err)
+		}
+	}
+
+	if contents.pkginfo == nil {
+		return nil, fmt.Errorf("%w: no .PKGINFO found in package", errDamagedPackage)
+	}
+	if !dataStream {
+		return nil, fmt.Errorf("%w: the package ends after its control data", errDamagedPackage)
+	}
+	return contents, nil
+}
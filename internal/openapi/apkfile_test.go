@@ -0,0 +1,94 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTarEntry struct {
+	name string
+	body string
+	mode int64
+}
+
+// gzipTar - a single gzip'd tar stream, end of archive marker is optional since the signature
+// stream in a real apk doesn't have one
+func gzipTar(t *testing.T, entries []testTarEntry, terminate bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for _, e := range entries {
+		mode := e.mode
+		if mode == 0 {
+			mode = 0644
+		}
+		err := tw.WriteHeader(&tar.Header{Name: e.name, Mode: mode, Size: int64(len(e.body)), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal("failed to write tar header", err)
+		}
+		_, err = tw.Write([]byte(e.body))
+		if err != nil {
+			t.Fatal("failed to write tar body", err)
+		}
+	}
+	if terminate {
+		err := tw.Close()
+		if err != nil {
+			t.Fatal("failed to close tar", err)
+		}
+	} else {
+		err := tw.Flush()
+		if err != nil {
+			t.Fatal("failed to flush tar", err)
+		}
+	}
+	err := zw.Close()
+	if err != nil {
+		t.Fatal("failed to close gzip", err)
+	}
+	return buf.Bytes()
+}
+
+// buildTestApk - put together a signed .apk for a package with a single binary in it
+func buildTestApk(t *testing.T, name, version, arch string) []byte {
+	t.Helper()
+	pkginfo := "# Generated by abuild\npkgname = " + name + "\npkgver = " + version + "\narch = " + arch +
+		"\npkgdesc = test package\nsize = 4\ndepend = so:libc.musl-x86_64.so.1\ndepend = busybox\n"
+	var apk []byte
+	apk = append(apk, gzipTar(t, []testTarEntry{{name: ".SIGN.RSA.test.rsa.pub", body: "sig"}}, false)...)
+	apk = append(apk, gzipTar(t, []testTarEntry{{name: ".PKGINFO", body: pkginfo}, {name: ".post-install", body: "#!/bin/sh\n", mode: 0755}}, true)...)
+	apk = append(apk, gzipTar(t, []testTarEntry{{name: "usr/bin/" + name, body: "test", mode: 0755}}, true)...)
+	return apk
+}
+
+func TestInspectApk(t *testing.T) {
+	contents, err := inspectApk(bytes.NewReader(buildTestApk(t, "foo", "1.0-r0", "x86_64")))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "test.rsa.pub", contents.signatureKey)
+	assert.Equal(t, []string{"foo"}, contents.pkginfo["pkgname"])
+	assert.Equal(t, []string{"so:libc.musl-x86_64.so.1", "busybox"}, contents.pkginfo["depend"])
+	assert.Equal(t, []string{".post-install"}, contents.scripts)
+	assert.Len(t, contents.files, 1)
+	assert.Equal(t, "usr/bin/foo", contents.files[0].name)
+	assert.Equal(t, int64(4), contents.files[0].size)
+	assert.Equal(t, int64(0755), contents.files[0].mode)
+	assert.Equal(t, File, contents.files[0].typ)
+
+	_, err = inspectApk(bytes.NewReader([]byte("not an apk")))
+	assert.ErrorIs(t, err, errDamagedPackage)
+
+	// cut short anywhere, including between streams, it isn't a package with fewer files
+	apk := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	for n := 1; n < len(apk); n++ {
+		_, err := inspectApk(bytes.NewReader(apk[:n]))
+		if !assert.ErrorIs(t, err, errDamagedPackage, "truncated to %d of %d bytes", n, len(apk)) {
+			break
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+)
+
+// every request that changes something gets a line in config/<org>/audit/<yyyy-mm-dd>.jsonl once
+// it's finished, whether it worked or not: who sent it (the name of their token file and the start
+// of the token's sha256), what it was, where it went, the packages it wrote and what happened.
+// the files are only ever appended to, a day at a time, so they can be shipped somewhere else or
+// pruned by date without the server being involved
+
+const (
+	auditDir = "audit"
+	// auditFilesKey - where handlers leave the files a request wrote for the audit middleware
+	auditFilesKey = "auditFiles"
+	// auditTokenIDLength - how much of the token's sha256 identifies it in the log
+	auditTokenIDLength = 12
+)
+
+// auditLock - entries are appended one at a time, so lines from concurrent requests can't interleave
+var auditLock sync.Mutex
+
+// specParamPattern - {name} path params in the spec, echo calls them :name
+var specParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// AuditLog - middleware that appends every mutating request to its org's audit log
+// it has to run before the request validator so requests it turns away are recorded too
+func AuditLog(swagger *openapi3.T) echo.MiddlewareFunc {
+	operations := make(map[string]string)
+	for path, item := range swagger.Paths.Map() {
+		route := specParamPattern.ReplaceAllString(path, ":$1")
+		for method, op := range item.Operations() {
+			operations[method+" "+route] = op.OperationID
+		}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			switch req.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(ctx)
+			}
+			operation, ok := operations[req.Method+" "+ctx.Path()]
+			if !ok {
+				return next(ctx)
+			}
+			err := next(ctx)
+			status := ctx.Response().Status
+			if err != nil {
+				// the error hasn't been turned into a response yet
+				status = http.StatusInternalServerError
+				var he *echo.HTTPError
+				if errors.As(err, &he) {
+					status = he.Code
+				}
+			}
+			// orgs without tokens don't exist, or at least nobody can do anything to them, don't
+			// create config dirs for whatever unauthenticated clients make up
+			org := ctx.Param("org")
+			tokens := orgTokens(org)
+			if validPathSegments(org) && len(tokens) > 0 {
+				recordAudit(context.WithoutCancel(req.Context()), PackageBaseDirectory, auditEntryFor(ctx, operation, status, tokens))
+			}
+			return err
+		}
+	}
+}
+
+// auditEntryFor - describe a finished request, tokens are its org's to find the name of the one it used
+func auditEntryFor(ctx echo.Context, operation string, status int, tokens map[string]string) AuditEntry {
+	req := ctx.Request()
+	entry := AuditEntry{
+		Time:      time.Now().UTC(),
+		Operation: operation,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Org:       ctx.Param("org"),
+		Distro:    optionalString(ctx.Param("distro")),
+		Version:   optionalString(ctx.Param("version")),
+		Repo:      optionalString(ctx.Param("repo")),
+		Arch:      optionalString(ctx.Param("arch")),
+		ClientIp:  ctx.RealIP(),
+		Status:    status,
+		Outcome:   Success,
+	}
+	if status >= http.StatusBadRequest {
+		entry.Outcome = Failure
+	}
+	if files, ok := ctx.Get(auditFilesKey).([]AuditFile); ok {
+		entry.Files = &files
+	}
+	token, ok := strings.CutPrefix(req.Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if ok && token != "" {
+		sum := sha256.Sum256([]byte(token))
+		entry.TokenId = optionalString(hex.EncodeToString(sum[:])[:auditTokenIDLength])
+		for name, t := range tokens {
+			if t == token {
+				entry.TokenName = optionalString(name)
+				break
+			}
+		}
+	}
+	return entry
+}
+
+// auditFiles - note files a request wrote for its audit log entry
+func auditFiles(ctx echo.Context, files ...AuditFile) {
+	existing, _ := ctx.Get(auditFilesKey).([]AuditFile)
+	ctx.Set(auditFilesKey, append(existing, files...))
+}
+
+// auditUpload - note an uploaded package for the request's audit log entry, once it's been stored
+func auditUpload(ctx echo.Context, arch string, up receivedUpload) {
+	auditFiles(ctx, AuditFile{
+		Arch:   optionalString(arch),
+		Name:   up.name,
+		Sha256: optionalString(up.staged.sha256),
+		Size:   &up.staged.size,
+	})
+}
+
+func auditDirURI(basedir, org string) string {
+	return url.JoinUNC(basedir, "config", org, auditDir)
+}
+
+// recordAudit - append an entry to its org's audit log
+// failing to is logged rather than failing the request, it's already happened
+func recordAudit(ctx context.Context, basedir string, entry AuditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Error().Err(err).Str("org", entry.Org).Msg("failed to encode audit entry")
+		return
+	}
+	line = append(line, '\n')
+	err = appendAuditLine(ctx, basedir, entry.Org, entry.Time, line)
+	if err != nil {
+		log.Error().Err(err).Str("org", entry.Org).Str("operation", entry.Operation).Msg("failed to write audit entry")
+	}
+}
+
+func appendAuditLine(ctx context.Context, basedir, org string, at time.Time, line []byte) error {
+	uri := url.JoinUNC(auditDirURI(basedir, org), at.Format(time.DateOnly)+".jsonl")
+	auditLock.Lock()
+	defer auditLock.Unlock()
+	if url.Scheme(uri, file.Scheme) == file.Scheme {
+		err := os.MkdirAll(url.Path(url.Dir(uri)), 0755)
+		if err != nil {
+			return err
+		}
+		fd, err := os.OpenFile(url.Path(uri), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+		if err != nil {
+			return err
+		}
+		_, err = fd.Write(line)
+		return errors.Join(err, fd.Close())
+	}
+
+	// object stores can't append, the day's file is rewritten with the new line on the end
+	cfs := afs.New()
+	var data []byte
+	ok, err := cfs.Exists(ctx, uri)
+	if err != nil {
+		return err
+	}
+	if ok {
+		data, err = cfs.DownloadWithURL(ctx, uri)
+		if err != nil {
+			return err
+		}
+	}
+	return cfs.Upload(ctx, uri, 0640, bytes.NewReader(append(data, line...)))
+}
+
+// auditQuery - which entries to read
+type auditQuery struct {
+	since     *time.Time
+	until     *time.Time
+	operation string
+}
+
+func (q auditQuery) matches(entry AuditEntry) bool {
+	if q.since != nil && entry.Time.Before(*q.since) {
+		return false
+	}
+	if q.until != nil && !entry.Time.Before(*q.until) {
+		return false
+	}
+	return q.operation == "" || entry.Operation == q.operation
+}
+
+// wantsDay - whether a day's file could have anything in the range
+func (q auditQuery) wantsDay(day time.Time) bool {
+	if q.since != nil && !day.AddDate(0, 0, 1).After(*q.since) {
+		return false
+	}
+	return q.until == nil || day.Before(*q.until)
+}
+
+// readAuditLog - call fn for each of an org's audit entries that match q, oldest first
+func readAuditLog(ctx context.Context, basedir, org string, q auditQuery, fn func(AuditEntry) error) error {
+	cfs := afs.New()
+	dir := url.Normalize(auditDirURI(basedir, org), file.Scheme)
+	ok, err := cfs.Exists(ctx, dir)
+	if err != nil || !ok {
+		return err
+	}
+	list, err := cfs.List(ctx, dir)
+	if err != nil {
+		return err
+	}
+	var days []string
+	for _, f := range list {
+		name, ok := strings.CutSuffix(f.Name(), ".jsonl")
+		if f.IsDir() || !ok {
+			continue
+		}
+		day, err := time.Parse(time.DateOnly, name)
+		if err != nil || !q.wantsDay(day) {
+			continue
+		}
+		days = append(days, f.Name())
+	}
+	sort.Strings(days)
+
+	for _, name := range days {
+		data, err := cfs.DownloadWithURL(ctx, url.JoinUNC(dir, name))
+		if err != nil {
+			return err
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			var entry AuditEntry
+			err := json.Unmarshal(scanner.Bytes(), &entry)
+			if err != nil {
+				// a line that was only partly written when the server stopped
+				log.Warn().Err(err).Str("org", org).Str("file", name).Msg("skipping unreadable audit entry")
+				continue
+			}
+			if !q.matches(entry) {
+				continue
+			}
+			err = fn(entry)
+			if err != nil {
+				return err
+			}
+		}
+		err = scanner.Err()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListAuditEntries - the audit log of an org, as json or json lines
+func (p *PkgRepoAPI) ListAuditEntries(ctx echo.Context, org string, params ListAuditEntriesParams) error {
+	if !validPathSegments(org) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org"})
+	}
+	q := auditQuery{since: params.Since, until: params.Until}
+	if params.Operation != nil {
+		q.operation = *params.Operation
+	}
+	reqCtx := ctx.Request().Context()
+
+	if params.Format != nil && *params.Format == Jsonl {
+		resp := ctx.Response()
+		resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		resp.WriteHeader(http.StatusOK)
+		enc := json.NewEncoder(resp)
+		err := readAuditLog(reqCtx, PackageBaseDirectory, org, q, func(entry AuditEntry) error {
+			return enc.Encode(entry)
+		})
+		if err != nil {
+			// too late to tell the client, the export just ends early
+			log.Error().Err(err).Str("org", org).Msg("failed to export audit log")
+		}
+		return nil
+	}
+
+	entries := []AuditEntry{}
+	err := readAuditLog(reqCtx, PackageBaseDirectory, org, q, func(entry AuditEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Msg("failed to read audit log")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to read the audit log"})
+	}
+	return ctx.JSON(http.StatusOK, entries)
+}
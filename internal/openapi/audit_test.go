@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-audit-*")
+	if err != nil {
+		t.Fatal("failed to create testAuditLog tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testAuditLog tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+
+	const org = "testorg"
+	assert.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config", org, "tokens"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "config", org, "tokens", "ci"), []byte("secret\n"), 0600))
+	swagger, err := GetSwagger()
+	assert.NoError(t, err)
+	e := echo.New()
+	e.Use(AuditLog(swagger))
+	RegisterHandlers(e, &PkgRepoAPI{})
+	upload := func(org, name string, data []byte) int {
+		req := httptest.NewRequest(http.MethodPut, "/"+org+"/alpine/edge/main/x86_64/"+name, bytes.NewReader(data))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer secret")
+		req.Header.Set(echo.HeaderContentType, "application/octet-stream")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	list := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/"+org+"/audit"+query, nil))
+		return rec
+	}
+
+	start := time.Now()
+	foo := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	sum := sha256.Sum256(foo)
+	assert.Equal(t, http.StatusOK, upload(org, "foo-1.0-r0.apk", foo))
+	assert.Equal(t, http.StatusBadRequest, upload(org, "bar-1.0-r0.apk", []byte("not a package")))
+	// reading isn't audited, and neither are orgs that don't exist
+	assert.Equal(t, http.StatusOK, list("").Code)
+	assert.Equal(t, http.StatusOK, upload("nobody", "foo-1.0-r0.apk", foo))
+	assert.NoDirExists(t, filepath.Join(tmpDir, "config", "nobody"))
+
+	rec := list("")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var entries []AuditEntry
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "UploadRepoFile", entries[0].Operation)
+		assert.Equal(t, Success, entries[0].Outcome)
+		assert.Equal(t, "ci", *entries[0].TokenName)
+		tokenSum := sha256.Sum256([]byte("secret"))
+		assert.Equal(t, hex.EncodeToString(tokenSum[:])[:auditTokenIDLength], *entries[0].TokenId)
+		assert.Equal(t, "main", *entries[0].Repo)
+		if assert.NotNil(t, entries[0].Files) && assert.Len(t, *entries[0].Files, 1) {
+			f := (*entries[0].Files)[0]
+			assert.Equal(t, "foo-1.0-r0.apk", f.Name)
+			assert.Equal(t, hex.EncodeToString(sum[:]), *f.Sha256)
+			assert.Equal(t, int64(len(foo)), *f.Size)
+		}
+		assert.Equal(t, http.StatusBadRequest, entries[1].Status)
+		assert.Equal(t, Failure, entries[1].Outcome)
+		assert.Nil(t, entries[1].Files)
+	}
+
+	// time ranges
+	rec = list("?until=" + start.UTC().Format(time.RFC3339Nano))
+	assert.Equal(t, "[]\n", rec.Body.String())
+	rec = list("?since=" + start.UTC().Format(time.RFC3339Nano) + "&operation=UploadRepoFile&format=jsonl")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get(echo.HeaderContentType))
+	lines := 0
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var entry AuditEntry
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+	rec = list("?operation=CreatePackage")
+	assert.Equal(t, "[]\n", rec.Body.String())
+}
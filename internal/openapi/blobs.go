@@ -0,0 +1,470 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gopkg.in/yaml.v3"
+)
+
+// with the blob store turned on, packages are stored once, by sha256, in
+// blobs/sha256/<first 2>/<sha256> however many arches, repos and versions they're uploaded to.
+// the files under static/ refer to them: on a local disk they're hard links, so anything that
+// reads static/ directly (like a web server in front of it) doesn't know the difference, on
+// object stores they're entries in blobs/refs/<org>/<distro>/<version>/<repo>/<arch>.yaml that the
+// listing functions here merge in. hard links get an entry too, so CollectBlobs can tell which
+// blobs are still used without hashing every package
+
+const (
+	blobsDir = "blobs"
+	// uploadsDir - where packages are written while they're coming in, before they're moved into a
+	// repo or the blob store
+	uploadsDir = "uploads"
+
+	blobGCInterval = time.Hour
+)
+
+// BlobStore - whether packages are written through the blob store
+var BlobStore bool
+
+var (
+	// blobsLock - writes hold it for reading from storing a blob until the ref to it is saved, and
+	// CollectBlobs for writing, so it can't remove a blob that's about to be used
+	blobsLock sync.RWMutex
+	// blobRefsLock - ref files are read, changed and written back one at a time
+	blobRefsLock sync.Mutex
+)
+
+// SetBlobStore - turn the blob store on or off, it only affects packages written from now on
+func SetBlobStore(enabled bool) {
+	BlobStore = enabled
+	log.Info().Bool("enabled", enabled).Msg("set blob store")
+}
+
+// blobRef - what a package in a repo refers to
+type blobRef struct {
+	SHA256   string    `yaml:"sha256"`
+	Size     int64     `yaml:"size"`
+	Modified time.Time `yaml:"modified"`
+	// Linked - the file under static/ is a hard link to the blob, so this only counts while it
+	// still exists
+	Linked bool `yaml:"linked,omitempty"`
+}
+
+func blobURI(basedir, sum string) string {
+	return url.JoinUNC(basedir, blobsDir, "sha256", sum[:2], sum)
+}
+
+func blobRefsURI(basedir string, loc RepoLocation) string {
+	return url.JoinUNC(basedir, blobsDir, "refs", loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch+".yaml")
+}
+
+// hardLink - hard link src to dst, both have to be local files
+func hardLink(src, dst string) error {
+	if url.Scheme(src, file.Scheme) != file.Scheme || url.Scheme(dst, file.Scheme) != file.Scheme {
+		return errors.New("hard links only work on local files")
+	}
+	err := os.MkdirAll(url.Path(url.Dir(dst)), 0755)
+	if err != nil {
+		return err
+	}
+	return os.Link(url.Path(src), url.Path(dst))
+}
+
+// loadBlobRefs - the refs for a repo location, keyed by file name
+func loadBlobRefs(ctx context.Context, cfs afs.Service, basedir string, loc RepoLocation) (map[string]blobRef, error) {
+	refs := map[string]blobRef{}
+	uri := blobRefsURI(basedir, loc)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil || !ex {
+		return refs, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	err = yaml.Unmarshal(data, &refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	return refs, nil
+}
+
+// setBlobRef - save (or with a nil ref, remove) the ref for a file in a repo location
+func setBlobRef(ctx context.Context, cfs afs.Service, basedir string, loc RepoLocation, name string, ref *blobRef) error {
+	blobRefsLock.Lock()
+	defer blobRefsLock.Unlock()
+	refs, err := loadBlobRefs(ctx, cfs, basedir, loc)
+	if err != nil {
+		return err
+	}
+	if _, ok := refs[name]; !ok && ref == nil {
+		return nil
+	}
+	if ref == nil {
+		delete(refs, name)
+	} else {
+		refs[name] = *ref
+	}
+	uri := blobRefsURI(basedir, loc)
+	_ = cfs.Delete(ctx, uri)
+	if len(refs) == 0 {
+		return nil
+	}
+	data, err := yaml.Marshal(refs)
+	if err != nil {
+		return err
+	}
+	return cfs.Upload(ctx, uri, 0644, bytes.NewReader(data))
+}
+
+// stagedFile - a file that's been written to uploads/, ready to be moved into a repo
+type stagedFile struct {
+	uri    string
+	sha256 string
+	size   int64
+}
+
+// errStaging - writing the staging file failed, as opposed to reading what goes in it
+type errStaging struct {
+	err error
+}
+
+func (e errStaging) Error() string { return e.err.Error() }
+func (e errStaging) Unwrap() error { return e.err }
+
+// stagingWriter - counts what's written to the staging file, and marks errors writing it
+type stagingWriter struct {
+	w    io.Writer
+	size int64
+}
+
+func (s *stagingWriter) Write(p []byte) (int, error) {
+	n, err := s.w.Write(p)
+	s.size += int64(n)
+	if err != nil {
+		err = errStaging{err}
+	}
+	return n, err
+}
+
+// stageFile - copy r to a staging file in uploads/ in one pass, hashing it on the way, and letting
+// inspect (if there is one) read it as it goes past too. the staging file is removed if anything
+// goes wrong, including ctx being cancelled part way through
+func stageFile(ctx context.Context, cfs afs.Service, basedir string, r io.Reader, inspect func(io.Reader) error) (stagedFile, error) {
+	var rnd [8]byte
+	_, _ = rand.Read(rnd[:])
+	staged := stagedFile{uri: url.JoinUNC(basedir, uploadsDir, hex.EncodeToString(rnd[:]))}
+	w, err := cfs.NewWriter(ctx, staged.uri, 0644)
+	if err != nil {
+		return staged, errStaging{err}
+	}
+	h := sha256.New()
+	sw := &stagingWriter{w: w}
+	src := io.TeeReader(r, io.MultiWriter(sw, h))
+	var inspectErr error
+	if inspect != nil {
+		inspectErr = inspect(src)
+	}
+	// whatever inspect didn't read
+	_, err = io.Copy(io.Discard, src)
+	closeErr := w.Close()
+	staged.size = sw.size
+	if err == nil && closeErr != nil {
+		err = errStaging{closeErr}
+	}
+	// a read error wins over inspect's, something that was cut off part way won't parse either
+	if err == nil {
+		err = inspectErr
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		_ = cfs.Delete(context.WithoutCancel(ctx), staged.uri)
+		return staged, err
+	}
+	staged.sha256 = hex.EncodeToString(h.Sum(nil))
+	return staged, nil
+}
+
+// commitRepoFile - move a staged file into a repo location, replacing any that's already there,
+// through the blob store if it's turned on. the staged file is gone afterwards either way
+func commitRepoFile(ctx context.Context, basedir string, loc RepoLocation, name string, staged stagedFile) error {
+	cfs := afs.New()
+	fileURI := url.JoinUNC(loc.staticURI(basedir), name)
+	if !BlobStore {
+		_ = cfs.Delete(ctx, fileURI) // we don't care if delete fails as the file probably doesn't even exist
+		err := cfs.Move(ctx, staged.uri, fileURI)
+		if err != nil {
+			_ = cfs.Delete(ctx, staged.uri)
+			return err
+		}
+		// in case it was written through the blob store before
+		return setBlobRef(ctx, cfs, basedir, loc, name, nil)
+	}
+
+	blobsLock.RLock()
+	defer blobsLock.RUnlock()
+	dst := blobURI(basedir, staged.sha256)
+	ex, err := cfs.Exists(ctx, dst)
+	if err == nil && !ex {
+		err = cfs.Move(ctx, staged.uri, dst)
+	}
+	if err != nil || ex {
+		_ = cfs.Delete(ctx, staged.uri)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+	_ = cfs.Delete(ctx, fileURI)
+	ref := blobRef{SHA256: staged.sha256, Size: staged.size, Modified: time.Now().UTC()}
+	err = hardLink(dst, fileURI)
+	if err == nil {
+		ref.Linked = true
+	} else if url.Scheme(fileURI, file.Scheme) == file.Scheme {
+		log.Warn().Err(err).Str("uri", fileURI).Msg("failed to hard link package to its blob, only keeping a ref")
+	}
+	return setBlobRef(ctx, cfs, basedir, loc, name, &ref)
+}
+
+// writeRepoFile - write a package into a repo location, replacing any that's already there, through
+// the blob store if it's turned on
+func writeRepoFile(ctx context.Context, basedir string, loc RepoLocation, name string, r io.Reader) (int64, error) {
+	staged, err := stageFile(ctx, afs.New(), basedir, r, nil)
+	if err != nil {
+		return staged.size, err
+	}
+	return staged.size, commitRepoFile(ctx, basedir, loc, name, staged)
+}
+
+// copyRepoFile - put a package that's in one repo location into another as well, sharing its
+// storage if possible: the same blob with the blob store, a hard link or a copy without it
+func copyRepoFile(ctx context.Context, basedir string, from, to RepoLocation, name string) error {
+	cfs := afs.New()
+	dst := url.JoinUNC(to.staticURI(basedir), name)
+	if BlobStore {
+		blobsLock.RLock()
+		defer blobsLock.RUnlock()
+		refs, err := loadBlobRefs(ctx, cfs, basedir, from)
+		if err != nil {
+			return err
+		}
+		if ref, ok := refs[name]; ok {
+			_ = cfs.Delete(ctx, dst)
+			ref.Linked = hardLink(blobURI(basedir, ref.SHA256), dst) == nil
+			ref.Modified = time.Now().UTC()
+			return setBlobRef(ctx, cfs, basedir, to, name, &ref)
+		}
+	}
+	src, err := resolveRepoFile(ctx, basedir, from, name)
+	if err != nil {
+		return err
+	}
+	_ = cfs.Delete(ctx, dst)
+	err = hardLink(src, dst)
+	if err != nil {
+		err = cfs.Copy(ctx, src, dst)
+	}
+	if err != nil {
+		return err
+	}
+	return setBlobRef(ctx, cfs, basedir, to, name, nil)
+}
+
+// removeRepoFile - remove a package from a repo location, wherever it's stored
+func removeRepoFile(ctx context.Context, basedir string, loc RepoLocation, name string) error {
+	cfs := afs.New()
+	fileURI := url.JoinUNC(loc.staticURI(basedir), name)
+	ex, err := cfs.Exists(ctx, fileURI)
+	if err != nil {
+		return err
+	}
+	if ex {
+		err = cfs.Delete(ctx, fileURI)
+		if err != nil {
+			return err
+		}
+	}
+	return setBlobRef(ctx, cfs, basedir, loc, name, nil)
+}
+
+// repoPackageFile - a package in a repo location, and where it's actually stored
+type repoPackageFile struct {
+	Name    string
+	URI     string
+	Size    int64
+	ModTime time.Time
+}
+
+// listRepoPackageFiles - the .apk files in a repo location, including the ones that are only
+// refs to blobs, sorted by name
+func listRepoPackageFiles(ctx context.Context, basedir string, loc RepoLocation) ([]repoPackageFile, error) {
+	cfs := afs.New()
+	dirURI := url.Normalize(loc.staticURI(basedir), file.Scheme)
+	var files []repoPackageFile
+	seen := map[string]bool{}
+	ex, err := cfs.Exists(ctx, dirURI)
+	if err != nil {
+		return nil, err
+	}
+	if ex {
+		list, err := cfs.List(ctx, dirURI)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range list {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), ".apk") {
+				continue
+			}
+			files = append(files, repoPackageFile{Name: f.Name(), URI: url.JoinUNC(dirURI, f.Name()), Size: f.Size(), ModTime: f.ModTime()})
+			seen[f.Name()] = true
+		}
+	}
+	refs, err := loadBlobRefs(ctx, cfs, basedir, loc)
+	if err != nil {
+		return nil, err
+	}
+	for name, ref := range refs {
+		// a hard link that's gone was deleted, not moved to an object store
+		if seen[name] || ref.Linked {
+			continue
+		}
+		files = append(files, repoPackageFile{Name: name, URI: blobURI(basedir, ref.SHA256), Size: ref.Size, ModTime: ref.Modified})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// resolveRepoFile - where a file in a repo location is actually stored, errNoRepoFile if it isn't
+func resolveRepoFile(ctx context.Context, basedir string, loc RepoLocation, name string) (string, error) {
+	cfs := afs.New()
+	fileURI := url.JoinUNC(loc.staticURI(basedir), name)
+	ex, err := cfs.Exists(ctx, fileURI)
+	if err != nil {
+		return "", err
+	}
+	if ex {
+		return fileURI, nil
+	}
+	refs, err := loadBlobRefs(ctx, cfs, basedir, loc)
+	if err != nil {
+		return "", err
+	}
+	ref, ok := refs[name]
+	if !ok || ref.Linked {
+		return "", errNoRepoFile
+	}
+	return blobURI(basedir, ref.SHA256), nil
+}
+
+// CollectBlobs - remove the blobs nothing refers to any more, returning how many were removed and
+// how big they were
+func CollectBlobs(ctx context.Context, basedir string) (int, int64, error) {
+	blobsLock.Lock()
+	defer blobsLock.Unlock()
+	cfs := afs.New()
+
+	used := map[string]bool{}
+	refsURI := url.JoinUNC(basedir, blobsDir, "refs")
+	ex, err := cfs.Exists(ctx, refsURI)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ex {
+		var locs []RepoLocation
+		err = cfs.Walk(ctx, refsURI, func(ctx context.Context, baseURL string, parent string, info os.FileInfo, reader io.Reader) (bool, error) {
+			parts := strings.Split(parent, "/")
+			if !info.IsDir() && strings.HasSuffix(info.Name(), ".yaml") && len(parts) == 4 {
+				locs = append(locs, RepoLocation{Org: parts[0], Distro: parts[1], Version: parts[2], Repo: parts[3], Arch: strings.TrimSuffix(info.Name(), ".yaml")})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, loc := range locs {
+			refs, err := loadBlobRefs(ctx, cfs, basedir, loc)
+			if err != nil {
+				// without them we can't tell what's safe to remove
+				return 0, 0, err
+			}
+			for name, ref := range refs {
+				if ref.Linked {
+					ex, err := cfs.Exists(ctx, url.JoinUNC(loc.staticURI(basedir), name))
+					if err != nil {
+						return 0, 0, err
+					}
+					if !ex {
+						continue
+					}
+				}
+				used[ref.SHA256] = true
+			}
+		}
+	}
+
+	removed := 0
+	var freed int64
+	blobsURI := url.JoinUNC(basedir, blobsDir, "sha256")
+	ex, err = cfs.Exists(ctx, blobsURI)
+	if err != nil || !ex {
+		return removed, freed, err
+	}
+	var unused []string
+	var sizes []int64
+	err = cfs.Walk(ctx, blobsURI, func(ctx context.Context, baseURL string, parent string, info os.FileInfo, reader io.Reader) (bool, error) {
+		if !info.IsDir() && !used[info.Name()] {
+			unused = append(unused, url.JoinUNC(blobsURI, parent, info.Name()))
+			sizes = append(sizes, info.Size())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return removed, freed, err
+	}
+	for i, uri := range unused {
+		err := cfs.Delete(ctx, uri)
+		if err != nil {
+			log.Error().Err(err).Str("uri", uri).Msg("failed to remove unused blob")
+			continue
+		}
+		removed++
+		freed += sizes[i]
+	}
+	return removed, freed, nil
+}
+
+// RunBlobGC - collect unused blobs every blobGCInterval until ctx is done
+func RunBlobGC(ctx context.Context, basedir string) {
+	ticker := time.NewTicker(blobGCInterval)
+	defer ticker.Stop()
+	for {
+		removed, freed, err := CollectBlobs(ctx, basedir)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to collect unused blobs")
+		} else if removed > 0 {
+			log.Info().Int("removed", removed).Int64("bytes", freed).Msg("collected unused blobs")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
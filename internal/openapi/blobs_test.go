@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/afs"
+)
+
+func TestBlobStore(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-blobs-*")
+	if err != nil {
+		t.Fatal("failed to create testBlobStore tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testBlobStore tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+	ctx := context.Background()
+	BlobStore = true
+	defer func() { BlobStore = false }()
+
+	_, err = createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	foo := buildTestApk(t, "foo", "1.0-r0", "noarch")
+	x86 := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	arm := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "aarch64"}
+
+	// the same package in two arches is only stored once, and hard linked into both
+	for _, loc := range []RepoLocation{x86, arm} {
+		size, err := writeRepoFile(ctx, basedir, loc, "foo-1.0-r0.apk", bytes.NewReader(foo))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(foo)), size)
+	}
+	blobs, err := filepath.Glob(filepath.Join(tmpDir, blobsDir, "sha256", "*", "*"))
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 1)
+	blobInfo, err := os.Stat(blobs[0])
+	assert.NoError(t, err)
+	for _, arch := range []string{"x86_64", "aarch64"} {
+		info, err := os.Stat(filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main", arch, "foo-1.0-r0.apk"))
+		assert.NoError(t, err)
+		assert.True(t, os.SameFile(blobInfo, info))
+	}
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+	pkgs, err := loadRepoIndex(basedir, x86)
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+
+	// nothing's unused yet
+	removed, _, err := CollectBlobs(ctx, basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, removed)
+
+	// the blob stays until the last ref to it is gone
+	bar := buildTestApk(t, "foo", "1.0-r0", "aarch64")
+	_, err = writeRepoFile(ctx, basedir, arm, "foo-1.0-r0.apk", bytes.NewReader(bar))
+	assert.NoError(t, err)
+	removed, _, err = CollectBlobs(ctx, basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, removed)
+	assert.NoError(t, removeRepoFile(ctx, basedir, x86, "foo-1.0-r0.apk"))
+	removed, freed, err := CollectBlobs(ctx, basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, int64(len(foo)), freed)
+	assert.NoFileExists(t, blobs[0])
+	files, err := listRepoPackageFiles(ctx, basedir, arm)
+	assert.NoError(t, err)
+	if assert.Len(t, files, 1) {
+		data, err := os.ReadFile(filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main", "aarch64", "foo-1.0-r0.apk"))
+		assert.NoError(t, err)
+		assert.Equal(t, bar, data)
+	}
+}
+
+func TestBlobStoreRefs(t *testing.T) {
+	// object stores can't hard link, so the repo only has refs to the blobs
+	basedir := "mem://localhost/test-blob-refs"
+	ctx := context.Background()
+	defer func() {
+		_ = afs.New().Delete(ctx, basedir)
+	}()
+	BlobStore = true
+	defer func() { BlobStore = false }()
+
+	foo := buildTestApk(t, "foo", "1.0-r0", "noarch")
+	x86 := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	arm := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "aarch64"}
+	for _, loc := range []RepoLocation{x86, arm} {
+		_, err := writeRepoFile(ctx, basedir, loc, "foo-1.0-r0.apk", bytes.NewReader(foo))
+		assert.NoError(t, err)
+	}
+
+	files, err := listRepoPackageFiles(ctx, basedir, x86)
+	assert.NoError(t, err)
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, "foo-1.0-r0.apk", files[0].Name)
+		assert.Equal(t, int64(len(foo)), files[0].Size)
+	}
+	x86URI, err := resolveRepoFile(ctx, basedir, x86, "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+	armURI, err := resolveRepoFile(ctx, basedir, arm, "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+	assert.Equal(t, x86URI, armURI)
+	data, err := afs.New().DownloadWithURL(ctx, x86URI)
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	_, err = resolveRepoFile(ctx, basedir, x86, "bar-1.0-r0.apk")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+
+	assert.NoError(t, removeRepoFile(ctx, basedir, x86, "foo-1.0-r0.apk"))
+	removed, _, err := CollectBlobs(ctx, basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, removed)
+	assert.NoError(t, removeRepoFile(ctx, basedir, arm, "foo-1.0-r0.apk"))
+	removed, _, err = CollectBlobs(ctx, basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	files, err = listRepoPackageFiles(ctx, basedir, arm)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -0,0 +1,309 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+// dependency - a parsed depends entry like so:libfoo.so.1, foo>=1.2 or !bar
+type dependency struct {
+	name       string
+	constraint *versionConstraint
+	conflict   bool
+}
+
+func parseDependency(dep string) dependency {
+	var d dependency
+	if strings.HasPrefix(dep, "!") {
+		d.conflict = true
+		dep = dep[1:]
+	}
+	d.name = stripVersion(dep)
+	if rest := dep[len(d.name):]; rest != "" {
+		c, err := parseVersionConstraint(rest)
+		if err == nil {
+			d.constraint = &c
+		}
+	}
+	return d
+}
+
+// provider - something that satisfies dependencies on a name, either the package itself or one of
+// its provides entries
+type provider struct {
+	pkg     *repository.Package
+	version string // empty for provides entries without a version
+	local   bool   // from the repo being checked rather than a parent
+}
+
+type providerMap map[string][]provider
+
+func (pm providerMap) add(pkg *repository.Package, local bool) {
+	pm[pkg.Name] = append(pm[pkg.Name], provider{pkg: pkg, version: pkg.Version, local: local})
+	for _, p := range pkg.Provides {
+		name := stripVersion(p)
+		var version string
+		if len(p) > len(name) && p[len(name)] == '=' {
+			version = p[len(name)+1:]
+		}
+		pm[name] = append(pm[name], provider{pkg: pkg, version: version, local: local})
+	}
+}
+
+// satisfying - the providers that satisfy a dependency
+func (pm providerMap) satisfying(d dependency) []provider {
+	var result []provider
+	for _, p := range pm[d.name] {
+		if d.constraint != nil {
+			// a provides entry without a version can't satisfy a versioned dependency
+			if p.version == "" || !d.constraint.matches(p.version) {
+				continue
+			}
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// parentsFileURI - the file listing the parent repos for a repo
+func parentsFileURI(basedir string, loc RepoLocation) string {
+	return url.JoinUNC(basedir, "config", loc.Org, loc.Distro, loc.Version, loc.Repo, "parents")
+}
+
+// loadParentPackages - load the packages from every parent of a repo
+// each line of the parents file is either the name of another repo in the same org/distro/version
+// or the path of a local APKINDEX.tar.gz (e.g. a copy of upstream alpine main), any {arch} in the
+// path gets replaced with the arch being checked
+func loadParentPackages(basedir string, loc RepoLocation) ([]*repository.Package, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+	parentsURI := parentsFileURI(basedir, loc)
+	ex, err := cfs.Exists(ctx, parentsURI)
+	if err != nil || !ex {
+		return nil, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, parentsURI)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*repository.Package
+	s := bufio.NewScanner(strings.NewReader(string(data)))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.ReplaceAll(line, "{arch}", loc.Arch)
+
+		if !strings.Contains(line, "/") {
+			parent := loc
+			parent.Repo = line
+			pkgs, err := loadRepoIndex(basedir, parent)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, pkgs...)
+			continue
+		}
+
+		if !strings.Contains(line, "://") {
+			line = "file://" + line
+		}
+		rdr, err := cfs.OpenURL(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		apki, err := repository.IndexFromArchive(rdr)
+		closeErr := rdr.Close()
+		if err != nil {
+			return nil, err
+		}
+		if closeErr != nil {
+			log.Debug().Err(closeErr).Str("uri", line).Msg("loadParentPackages: failed to close index")
+		}
+		result = append(result, apki.Packages...)
+	}
+	return result, s.Err()
+}
+
+// checkDependencies - find unsatisfied dependencies, names with more than one provider and
+// dependency cycles in pkgs, using parents to satisfy dependencies that aren't in pkgs
+func checkDependencies(arch string, pkgs, parents []*repository.Package) DependencyReport {
+	report := DependencyReport{
+		Arch:        arch,
+		Packages:    len(pkgs),
+		Unsatisfied: []UnsatisfiedDependency{},
+		Conflicts:   []ProviderConflict{},
+		Cycles:      [][]string{},
+	}
+
+	providers := providerMap{}
+	for _, pkg := range pkgs {
+		providers.add(pkg, true)
+	}
+	for _, pkg := range parents {
+		providers.add(pkg, false)
+	}
+
+	// edges between local package names, used for cycle detection
+	graph := map[string]map[string]bool{}
+	for _, pkg := range pkgs {
+		if graph[pkg.Name] == nil {
+			graph[pkg.Name] = map[string]bool{}
+		}
+		for _, dep := range pkg.Dependencies {
+			d := parseDependency(dep)
+			if d.conflict {
+				continue
+			}
+			found := providers.satisfying(d)
+			if len(found) == 0 {
+				report.Unsatisfied = append(report.Unsatisfied, UnsatisfiedDependency{
+					Package:    pkg.Name,
+					Version:    pkg.Version,
+					Dependency: dep,
+				})
+				continue
+			}
+			for _, p := range found {
+				if p.local && p.pkg.Name != pkg.Name {
+					graph[pkg.Name][p.pkg.Name] = true
+				}
+			}
+		}
+	}
+
+	// a name provided by more than one local package (rather than more than one version of the
+	// same package) means apk has to pick one
+	for name, provs := range providers {
+		var names []string
+		seen := map[string]bool{}
+		for _, p := range provs {
+			if p.local && !seen[p.pkg.Name] {
+				seen[p.pkg.Name] = true
+				names = append(names, p.pkg.Name)
+			}
+		}
+		if len(names) > 1 {
+			sort.Strings(names)
+			report.Conflicts = append(report.Conflicts, ProviderConflict{Name: name, Providers: names})
+		}
+	}
+	sort.Slice(report.Conflicts, func(i, j int) bool { return report.Conflicts[i].Name < report.Conflicts[j].Name })
+
+	report.Cycles = findCycles(graph)
+	return report
+}
+
+// findCycles - tarjan's strongly connected components, every component with more than one member is
+// a dependency cycle
+func findCycles(graph map[string]map[string]bool) [][]string {
+	cycles := [][]string{}
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	next := 0
+
+	var strongConnect func(v string)
+	strongConnect = func(v string) {
+		index[v] = next
+		lowlink[v] = next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for w := range graph[v] {
+			if _, visited := index[w]; !visited {
+				strongConnect(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			var component []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			if len(component) > 1 {
+				sort.Strings(component)
+				cycles = append(cycles, component)
+			}
+		}
+	}
+
+	// visit in a fixed order so the report is the same every time
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, visited := index[name]; !visited {
+			strongConnect(name)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+// checkRepoDependencies - run the dependency check for a repo location
+func checkRepoDependencies(basedir string, loc RepoLocation) (DependencyReport, error) {
+	pkgs, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		return DependencyReport{}, err
+	}
+	parents, err := loadParentPackages(basedir, loc)
+	if err != nil {
+		return DependencyReport{}, err
+	}
+	return checkDependencies(loc.Arch, pkgs, parents), nil
+}
+
+// CheckRepoDependencies - report dependency problems in a repo for each arch
+func (p *PkgRepoAPI) CheckRepoDependencies(ctx echo.Context, org, distro, version, repo string, params CheckRepoDependenciesParams) error {
+	filter := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	if params.Arch != nil {
+		filter.Arch = *params.Arch
+	}
+	locs, err := FindRepoLocations(PackageBaseDirectory, filter)
+	if err != nil {
+		log.Error().Err(err).Interface("location", filter).Msg("failed to find repo arches")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to find repo arches"})
+	}
+
+	reports := []DependencyReport{}
+	for _, loc := range locs {
+		report, err := checkRepoDependencies(PackageBaseDirectory, loc)
+		if err != nil {
+			log.Error().Err(err).Interface("location", loc).Msg("failed to check dependencies")
+			return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to check dependencies for " + loc.Arch})
+		}
+		reports = append(reports, report)
+	}
+
+	return ctx.JSON(http.StatusOK, reports)
+}
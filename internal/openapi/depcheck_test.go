@@ -0,0 +1,88 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+func TestParseDependency(t *testing.T) {
+	d := parseDependency("so:libfoo.so.1")
+	assert.Equal(t, "so:libfoo.so.1", d.name)
+	assert.Nil(t, d.constraint)
+
+	d = parseDependency("foo>=1.2")
+	assert.Equal(t, "foo", d.name)
+	assert.Equal(t, ">=", d.constraint.op)
+
+	d = parseDependency("!bar")
+	assert.True(t, d.conflict)
+	assert.Equal(t, "bar", d.name)
+}
+
+func TestCheckDependencies(t *testing.T) {
+	parents := []*repository.Package{
+		{Name: "musl", Version: "1.2.5-r0", Provides: []string{"so:libc.musl-x86_64.so.1=1"}},
+	}
+	pkgs := []*repository.Package{
+		{Name: "foo", Version: "1.0-r0", Dependencies: []string{"so:libc.musl-x86_64.so.1", "libbar>=2", "!oldfoo"}},
+		{Name: "libbar", Version: "1.5-r0", Provides: []string{"so:libbar.so.1=1.5"}},
+		{Name: "baz", Version: "1.0-r0", Dependencies: []string{"so:libtesting.so.1"}},
+		{Name: "a", Version: "1.0-r0", Dependencies: []string{"b"}, Provides: []string{"cmd:tool"}},
+		{Name: "b", Version: "1.0-r0", Dependencies: []string{"a"}, Provides: []string{"cmd:tool"}},
+	}
+
+	report := checkDependencies("x86_64", pkgs, parents)
+	assert.Equal(t, 5, report.Packages)
+	assert.Len(t, report.Unsatisfied, 2)
+	assert.Equal(t, "foo", report.Unsatisfied[0].Package)
+	assert.Equal(t, "libbar>=2", report.Unsatisfied[0].Dependency)
+	assert.Equal(t, "so:libtesting.so.1", report.Unsatisfied[1].Dependency)
+	assert.Equal(t, []ProviderConflict{{Name: "cmd:tool", Providers: []string{"a", "b"}}}, report.Conflicts)
+	assert.Equal(t, [][]string{{"a", "b"}}, report.Cycles)
+}
+
+func TestCheckRepoDependenciesWithParents(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-depcheck-*")
+	if err != nil {
+		t.Fatal("failed to create testCheckRepoDependencies tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testCheckRepoDependencies tmpDir", err)
+		}
+	}()
+
+	mainRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	testingRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "testing", Arch: "x86_64"}
+	writeTestIndex(t, tmpDir, mainRepo, []*repository.Package{
+		{Name: "foo", Version: "1.0-r0", Dependencies: []string{"libbar"}},
+	})
+	writeTestIndex(t, tmpDir, testingRepo, []*repository.Package{
+		{Name: "libbar", Version: "1.0-r0"},
+	})
+
+	basedir := "file://" + tmpDir
+	report, err := checkRepoDependencies(basedir, mainRepo)
+	assert.NoError(t, err)
+	assert.Len(t, report.Unsatisfied, 1)
+
+	// once testing is a parent of main, libbar is available
+	configDir := filepath.Join(tmpDir, "config", "testorg", "alpine", "edge", "main")
+	err = os.MkdirAll(configDir, 0755)
+	if err != nil {
+		t.Fatal("failed to create testCheckRepoDependencies config path", err)
+	}
+	err = os.WriteFile(filepath.Join(configDir, "parents"), []byte("# sibling repos\ntesting\n"), 0644)
+	if err != nil {
+		t.Fatal("failed to write testCheckRepoDependencies parents", err)
+	}
+	report, err = checkRepoDependencies(basedir, mainRepo)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Unsatisfied)
+}
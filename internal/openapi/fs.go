@@ -4,14 +4,12 @@ package api
 import (
 	"bytes"
 	"context"
-	"crypto/x509"
-	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"os"
-	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/viant/afs"
@@ -24,13 +22,22 @@ import (
 // GetValidTokens - return an array of token strings
 // this is exported because it gets called in the auth validator in cmd/api/main.go
 func GetValidTokens(org string) []string {
-	ctx := context.Background()
 	var tokens []string
+	for _, tok := range orgTokens(org) {
+		tokens = append(tokens, tok)
+	}
+	return tokens
+}
+
+// orgTokens - an org's tokens, keyed by the name of the file they're in
+func orgTokens(org string) map[string]string {
+	ctx := context.Background()
+	tokens := make(map[string]string)
 	configURI := PackageBaseDirectory + "/config/" + org + "/tokens/" // filepath.join condenses the consectutive
 	tokenFS := afs.New()
 	err := tokenFS.Init(ctx, PackageBaseDirectory)
 	if err != nil {
-		log.Error().Err(err).Str("org", org).Str("uri", configURI).Msg("GetValidTokens: failed to create NewLocation")
+		log.Error().Err(err).Str("org", org).Str("uri", configURI).Msg("orgTokens: failed to create NewLocation")
 		return tokens
 	}
 
@@ -44,16 +51,18 @@ func GetValidTokens(org string) []string {
 		}
 		fd, err := tokenFS.Open(ctx, t)
 		if err != nil || fd == nil {
-			log.Error().Err(err).Msg("GetValidTokens: failed to create new file from token path")
+			log.Error().Err(err).Msg("orgTokens: failed to create new file from token path")
+			continue
 		}
 		var tok = make([]byte, 256)
 		c, err := fd.Read(tok)
+		_ = fd.Close()
 		if err != nil {
 			log.Error().Err(err).Int("read count", c).Msg("failed to read from fd")
 		}
 		tok = bytes.TrimSpace(tok[0:c])
 		if len(tok) > 0 {
-			tokens = append(tokens, string(tok))
+			tokens[t.Name()] = string(tok)
 		}
 	}
 
@@ -125,31 +134,16 @@ func listRepos(org, distro, version string) ([]Repo, error) {
 }
 
 func listPackages(org, distro, version, repo, arch string) ([]Package, error) {
-	ctx := context.Background()
-	configURI := url.JoinUNC(PackageBaseDirectory, "static", org, distro, version, repo, arch)
-	cfs := afs.New()
-	err := cfs.Init(ctx, configURI)
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	files, err := listRepoPackageFiles(context.Background(), PackageBaseDirectory, loc)
 	if err != nil {
-		log.Error().Err(err).Str("org", org).Str("uri", configURI).Str("distro", distro).Msg("failed to init afs")
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Str("repo", repo).Str("arch", arch).Msg("listPackages: failed to list packages")
 		return []Package{}, err
 	}
 	result := []Package{}
-
-	walkerF := func(ctx context.Context, baseURL string, parent string, info os.FileInfo, reader io.Reader) (toContinue bool, err error) {
-		if strings.HasSuffix(info.Name(), ".apk") {
-			if parent == "" {
-				// if parent is set that means we've recursed
-				result = append(result, Package{Name: info.Name()})
-			}
-		}
-		return true, nil
+	for _, f := range files {
+		result = append(result, Package{Name: f.Name})
 	}
-
-	err = cfs.Walk(ctx, configURI, walkerF)
-	if err != nil {
-		log.Error().Err(err).Msg("listPackages: failed to walk dirs")
-	}
-
 	return result, nil
 }
 
@@ -251,66 +245,94 @@ func listDistros(org string) ([]Distribution, error) {
 	return result, nil
 }
 
-func writeUploadedPkg(f *multipart.FileHeader, org, distro, version, repo, arch string) {
-	log.Debug().Msg("writing uploaded package")
-	ctx := context.Background()
-	staticURI := url.JoinUNC(PackageBaseDirectory, "static", org, distro, version, repo, arch)
-
-	pkgFile, err := f.Open()
-	if err != nil {
-		log.Error().Err(err).Msg("failed to open pkg file")
-		return
-	}
-
-	cfs := afs.New()
-	err = cfs.Init(ctx, PackageBaseDirectory)
-	if err != nil {
-		log.Error().Err(err).Msg("writeUploadedPkg: failed to init cfs")
-		return
-	}
-	outFileName := url.JoinUNC(staticURI, f.Filename)
-	_ = cfs.Delete(ctx, outFileName) // we don't care if delete fails as the file probably doesn't even exist
-	outFile, err := cfs.NewWriter(ctx, outFileName, 0644)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to create outfile")
-	}
-
-	c, err := io.Copy(outFile, pkgFile)
-	if err != nil || c == 0 {
-		log.Error().Err(err).Int64("count", c).Msg("failed to copy uploaded pkg file to outFile")
-	}
+// indexLocks - one per repo location, held while its index is generated, and by a transaction commit
+// while it moves its packages in, so an index never has only some of a transaction's packages
+var indexLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: make(map[string]*sync.Mutex)}
+
+// indexLock - the lock for a repo location's index
+func indexLock(basedir string, loc RepoLocation) *sync.Mutex {
+	indexLocks.Lock()
+	defer indexLocks.Unlock()
+	uri := loc.staticURI(basedir)
+	l, ok := indexLocks.locks[uri]
+	if !ok {
+		l = &sync.Mutex{}
+		indexLocks.locks[uri] = l
+	}
+	return l
 }
 
 // GenerateAPKIndex - (re)generate the APKINDEX file
 // this runs in the background because it can take quite a while
 // regenerate the APKINDEX
 // TODO make sure we don't run this unnecessarily
-func GenerateAPKIndex(basedir, org, distro, version, repo, arch string) {
+// errors are logged as well as returned, since this is usually run in a goroutine
+func GenerateAPKIndex(basedir, org, distro, version, repo, arch string) error {
+	return GenerateAPKIndexWithSigners(basedir, org, distro, version, repo, arch)
+}
+
+// GenerateAPKIndexWithSigners - GenerateAPKIndex, but sign with signers instead of the distro's
+// active (and rotating) keys (no signers uses the distro's keys as usual)
+func GenerateAPKIndexWithSigners(basedir, org, distro, version, repo, arch string, signers ...Signer) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	// a mirror or proxy's index is upstream's, signed with upstream's keys, replacing it would break it
+	err := readOnlyRepoError(basedir, loc)
+	if err != nil && !errors.Is(err, errVirtualRepo) {
+		log.Warn().Err(err).Str("org", org).Str("distro", distro).Str("version", version).Str("repo", repo).Msg("not generating an index")
+		return err
+	}
+	virtual, err := loadVirtualConfig(basedir, loc)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Str("version", version).Str("repo", repo).Msg("failed to load virtual repo config")
+		return err
+	}
+	indexQueueDepth.Inc()
+	defer indexQueueDepth.Dec()
+	start := time.Now()
+	if virtual != nil {
+		err = generateVirtualIndex(basedir, loc, *virtual, signers)
+	} else {
+		l := indexLock(basedir, loc)
+		l.Lock()
+		err = generateAPKIndex(basedir, org, distro, version, repo, arch, signers)
+		l.Unlock()
+	}
+	observeIndexGeneration(loc, time.Since(start), err)
+	if err == nil && virtual == nil {
+		regenerateVirtualRepos(basedir, loc, signers)
+	}
+	return err
+}
+
+func generateAPKIndex(basedir, org, distro, version, repo, arch string, signers []Signer) error {
 	log.Info().Str("org", org).Str("distro", distro).Str("version", version).Str("repo", repo).Str("arch", arch).Msg("starting APK index generation")
 	var apki repository.ApkIndex
 	apki.Description = fmt.Sprintf("%s %s %s", org, repo, version)
 
 	ctx := context.Background()
-	configURI := url.JoinUNC(basedir, "config", org, distro)
 	staticURI := url.JoinUNC(basedir, "static", org, distro, version, repo, arch)
 	cfs := afs.New()
 	err := cfs.Init(ctx, basedir)
 	if err != nil {
 		log.Error().Err(err).Msg("generateAPKIndex: failed to init cfs")
-		return
+		return fmt.Errorf("failed to init cfs: %w", err)
 	}
 
 	// First, collect all .apk files
-	fileList, err := cfs.List(ctx, staticURI)
+	apkFiles, err := listRepoPackageFiles(ctx, basedir, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch})
 	if err != nil {
 		log.Error().Err(err).Str("URI", staticURI).Msg("failed to list package directory")
-		return
-	}
-
-	var apkFiles []string
-	for _, f := range fileList {
-		if !f.IsDir() && strings.HasSuffix(f.Name(), ".apk") {
-			apkFiles = append(apkFiles, f.Name())
+		return fmt.Errorf("failed to list package directory: %w", err)
+	}
+	if len(apkFiles) == 0 {
+		// don't create an empty index for an arch that doesn't exist
+		ex, err := cfs.Exists(ctx, staticURI)
+		if err != nil || !ex {
+			log.Error().Err(err).Str("URI", staticURI).Msg("package directory doesn't exist")
+			return fmt.Errorf("failed to list package directory: %s doesn't exist", staticURI)
 		}
 	}
 
@@ -322,11 +344,9 @@ func GenerateAPKIndex(basedir, org, distro, version, repo, arch string) {
 	g, gctx := errgroup.WithContext(ctx)
 	g.SetLimit(IndexWorkers) // Use configurable worker count
 
-	for _, filename := range apkFiles {
-		filename := filename // Capture for closure
+	for _, f := range apkFiles {
+		filename, fileURL := f.Name, f.URI // Capture for closure
 		g.Go(func() error {
-			fileURL := url.JoinUNC(staticURI, filename)
-
 			// Open and read the file
 			data, err := cfs.DownloadWithURL(gctx, fileURL)
 			if err != nil {
@@ -337,6 +357,7 @@ func GenerateAPKIndex(basedir, org, distro, version, repo, arch string) {
 			// Parse the package
 			pkg, err := repository.ParsePackage(bytes.NewReader(data))
 			if err != nil {
+				indexParseFailuresTotal.WithLabelValues(org, distro, version, repo, arch).Inc()
 				log.Error().Err(err).Str("filename", filename).Msg("generateAPKIndex: failed to parse package")
 				return nil // Don't fail entire index generation
 			}
@@ -358,81 +379,70 @@ func GenerateAPKIndex(basedir, org, distro, version, repo, arch string) {
 	// Wait for all concurrent package processing to complete
 	if err := g.Wait(); err != nil {
 		log.Error().Err(err).Msg("generateAPKIndex: error during concurrent package processing")
-		return
+		return fmt.Errorf("error during concurrent package processing: %w", err)
 	}
 
 	log.Info().Int("total_packages", packageCount).Msg("generateAPKIndex: finished parsing packages")
 
-	distroDirContents, err := cfs.List(ctx, configURI)
+	err = writeSignedIndex(basedir, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}, &apki, signers)
 	if err != nil {
-		log.Error().Err(err).Str("uri", configURI).Msg("failed to list rsa key")
-		return
+		return err
 	}
+	indexPackages.WithLabelValues(org, distro, version, repo, arch).Set(float64(packageCount))
+	log.Info().Str("org", org).Str("distro", distro).Str("version", version).Str("repo", repo).Str("arch", arch).Msg("finished generating apk index")
+	return nil
+}
 
-	// the afs matcher thing doesn't seem to work, so we have to find it the hard way
-	var keyFd io.ReadCloser
-	var keyName string
-	for _, f := range distroDirContents {
-		if strings.HasSuffix(f.Name(), ".rsa") {
-			keyFd, err = cfs.Open(ctx, f)
-			if err != nil {
-				log.Error().Err(err).Str("uri", configURI).Msg("failed to open rsa key")
-				return
-			}
-			keyName = f.Name()
+// writeSignedIndex - sign an index with signers (the distro's keys if there aren't any) and
+// write it to a repo location
+func writeSignedIndex(basedir string, loc RepoLocation, apki *repository.ApkIndex, signers []Signer) error {
+	ctx := context.Background()
+	cfs := afs.New()
+	if len(signers) == 0 {
+		keys, err := indexSigningKeys(basedir, loc.Org, loc.Distro)
+		if err != nil {
+			log.Error().Err(err).Str("uri", url.JoinUNC(basedir, "config", loc.Org, loc.Distro)).Msg("writeSignedIndex: failed to load signing keys")
+			return err
+		}
+		for _, k := range keys {
+			signers = append(signers, k.signer)
 		}
 	}
 
-	if keyFd == nil {
-		log.Error().Str("uri", configURI).Msg("generateAPKIndex: no RSA key found")
-		return
-	}
-
-	keyData, err := io.ReadAll(keyFd)
-	if err != nil {
-		log.Error().Err(err).Str("uri", configURI).Msg("failed to read rsa key")
-		return
-	}
-
-	der, _ := pem.Decode(keyData)
-	if der == nil {
-		log.Error().Str("uri", configURI).Msg("generateAPKIndex: failed to decode PEM")
-		return
-	}
-
-	key, err := x509.ParsePKCS1PrivateKey(der.Bytes)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to parse key from der")
-		return
-	}
-
-	archive, err := repository.ArchiveFromIndex(&apki)
+	archive, err := repository.ArchiveFromIndex(apki)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to generate archive from index")
-		return
+		return fmt.Errorf("failed to generate archive from index: %w", err)
 	}
-	signedarchive, err := repository.SignArchive(archive, key, keyName+".pub")
+	archiveBytes, err := io.ReadAll(archive)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to sign archive")
-		return
+		log.Error().Err(err).Msg("failed to read archive bytes")
+		return fmt.Errorf("failed to read archive bytes: %w", err)
 	}
-	sabytes, err := io.ReadAll(signedarchive)
+	// during a key rotation this is signed with both the old and the new key
+	sabytes, err := signArchive(archiveBytes, signers)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to read signed archive bytes")
-		return
+		log.Error().Err(err).Msg("failed to sign archive")
+		return fmt.Errorf("failed to sign archive: %w", err)
 	}
 
-	outFilePath := url.JoinUNC(staticURI, "APKINDEX.tar.gz")
+	outFilePath := url.JoinUNC(loc.staticURI(basedir), "APKINDEX.tar.gz")
 	_ = cfs.Delete(ctx, outFilePath) // we don't care if delete fails as the file may not even exist
 	outFile, err := cfs.NewWriter(ctx, outFilePath, 0644)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to create outfile")
-		return
+		return fmt.Errorf("failed to create outfile: %w", err)
 	}
 	c, err := outFile.Write(sabytes)
 	if err != nil || c == 0 {
 		log.Error().Err(err).Int("count", c).Msg("failed to write signed archive")
-		return
+		_ = outFile.Close()
+		return fmt.Errorf("failed to write signed archive (wrote %d bytes): %v", c, err)
 	}
-	log.Info().Str("org", org).Str("distro", distro).Str("version", version).Str("repo", repo).Str("arch", arch).Msg("finished generating apk index")
+	err = outFile.Close()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to close signed archive")
+		return fmt.Errorf("failed to close signed archive: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+)
+
+// IndexStatus - how an APKINDEX.tar.gz compares to the packages next to it
+type IndexStatus struct {
+	// HasIndex - false if there's no APKINDEX.tar.gz at all
+	HasIndex bool
+	// Packages - the number of .apk files on disk
+	Packages int
+	// Unindexed - .apk files that aren't in the index
+	Unindexed []string
+	// Missing - index entries without an .apk file
+	Missing []string
+	// Modified - .apk files that changed after the index was written
+	Modified []string
+}
+
+// UpToDate - whether the index matches the packages on disk
+func (s IndexStatus) UpToDate() bool {
+	return s.HasIndex && len(s.Unindexed) == 0 && len(s.Missing) == 0 && len(s.Modified) == 0
+}
+
+// CheckAPKIndex - compare the APKINDEX for a repo location with the .apk files on disk
+// packages are matched up by filename, and anything written after the index counts as modified
+// since that's what a re-upload of the same version looks like
+// this is exported for the cli, which works on a local copy of the tree
+func CheckAPKIndex(basedir string, loc RepoLocation) (IndexStatus, error) {
+	var status IndexStatus
+	ctx := context.Background()
+	dirURI := url.Normalize(loc.staticURI(basedir), file.Scheme)
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return status, err
+	}
+
+	files, err := listRepoPackageFiles(ctx, basedir, loc)
+	if err != nil {
+		return status, err
+	}
+	status.HasIndex, err = cfs.Exists(ctx, url.JoinUNC(dirURI, "APKINDEX.tar.gz"))
+	if err != nil {
+		return status, err
+	}
+
+	onDisk := map[string]bool{}
+	indexed := map[string]bool{}
+	for _, f := range files {
+		onDisk[f.Name] = true
+	}
+	status.Packages = len(onDisk)
+	if !status.HasIndex {
+		return status, nil
+	}
+
+	index, err := cfs.Object(ctx, url.JoinUNC(dirURI, "APKINDEX.tar.gz"))
+	if err != nil {
+		return status, err
+	}
+	pkgs, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		return status, err
+	}
+	for _, pkg := range pkgs {
+		name := pkg.Filename()
+		indexed[name] = true
+		if !onDisk[name] {
+			status.Missing = append(status.Missing, name)
+		}
+	}
+	for _, f := range files {
+		if !indexed[f.Name] {
+			status.Unindexed = append(status.Unindexed, f.Name)
+		} else if f.ModTime.After(index.ModTime()) {
+			status.Modified = append(status.Modified, f.Name)
+		}
+	}
+
+	sort.Strings(status.Unindexed)
+	sort.Strings(status.Missing)
+	sort.Strings(status.Modified)
+	return status, nil
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+func TestCheckAPKIndex(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-indexcheck-*")
+	if err != nil {
+		t.Fatal("failed to create testCheckAPKIndex tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testCheckAPKIndex tmpDir", err)
+		}
+	}()
+
+	basedir := "file://" + tmpDir
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	dir := filepath.Join(tmpDir, "static", loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test repo dir", err)
+	}
+
+	writePkg := func(name string) {
+		err := os.WriteFile(filepath.Join(dir, name), []byte("not really an apk"), 0644)
+		if err != nil {
+			t.Fatal("failed to write test package", err)
+		}
+	}
+	writePkg("foo-1.0-r0.apk")
+	writePkg("bar-2.0-r0.apk")
+
+	// no index yet
+	status, err := CheckAPKIndex(basedir, loc)
+	assert.NoError(t, err)
+	assert.False(t, status.HasIndex)
+	assert.False(t, status.UpToDate())
+	assert.Equal(t, 2, status.Packages)
+
+	writeTestIndex(t, tmpDir, loc, []*repository.Package{
+		{Name: "foo", Version: "1.0-r0"},
+		{Name: "bar", Version: "2.0-r0"},
+	})
+	// make sure the packages are older than the index regardless of the filesystem's timestamp resolution
+	old := time.Now().Add(-time.Hour)
+	for _, name := range []string{"foo-1.0-r0.apk", "bar-2.0-r0.apk"} {
+		err = os.Chtimes(filepath.Join(dir, name), old, old)
+		if err != nil {
+			t.Fatal("failed to set test package mtime", err)
+		}
+	}
+
+	status, err = CheckAPKIndex(basedir, loc)
+	assert.NoError(t, err)
+	assert.True(t, status.UpToDate())
+
+	// a new package, a deleted package and a re-uploaded package
+	writePkg("baz-3.0-r0.apk")
+	err = os.Remove(filepath.Join(dir, "bar-2.0-r0.apk"))
+	assert.NoError(t, err)
+	future := time.Now().Add(time.Hour)
+	err = os.Chtimes(filepath.Join(dir, "foo-1.0-r0.apk"), future, future)
+	assert.NoError(t, err)
+
+	status, err = CheckAPKIndex(basedir, loc)
+	assert.NoError(t, err)
+	assert.False(t, status.UpToDate())
+	assert.Equal(t, []string{"baz-3.0-r0.apk"}, status.Unindexed)
+	assert.Equal(t, []string{"bar-2.0-r0.apk"}, status.Missing)
+	assert.Equal(t, []string{"foo-1.0-r0.apk"}, status.Modified)
+}
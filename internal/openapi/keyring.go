@@ -0,0 +1,249 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/url"
+)
+
+// the keyring is an <org>-keys package that drops a distro's public keys into /etc/apk/keys, it's
+// written to static/<org>/<distro>/<org>-keys.apk so it can be fetched before the repo is trusted,
+// and signed with the distro's keys so later versions verify. it's only ever built when the keys
+// change (or when asked to with POST .../keyring), never when it's downloaded, since that needs the
+// private keys
+
+// keyringVersionFormat - the keyring's pkgver is when the keys last changed, so rebuilding it
+// without any key changes gives the same version
+const keyringVersionFormat = "20060102.150405"
+
+// keyringName - the keyring package's name for an org
+func keyringName(org string) string {
+	return org + "-keys"
+}
+
+// keyringURI - where the keyring package is published
+func keyringURI(basedir, org, distro string) string {
+	return url.JoinUNC(basedir, "static", org, distro, keyringName(org)+".apk")
+}
+
+// keyringTime - the last time anything about the keys changed
+func keyringTime(keys []distroKey) time.Time {
+	var latest time.Time
+	for _, k := range keys {
+		for _, t := range []time.Time{k.meta.Created, k.meta.Activated, k.meta.Retired} {
+			if t.After(latest) {
+				latest = t
+			}
+		}
+	}
+	return latest.UTC().Truncate(time.Second)
+}
+
+// buildKeyring - build the keyring package for a distro's keys
+// an apk is three gzip streams: the signatures, the control tar (just .PKGINFO) and the data tar,
+// the signatures cover the control stream and .PKGINFO has the sha256 of the data stream
+func buildKeyring(org, distro string, keys []distroKey) ([]byte, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys to put in the keyring")
+	}
+	var signers []Signer
+	for _, k := range keys {
+		// rotating keys too, clients that only trust the old key need to be able to install the
+		// keyring that brings in the new one
+		if k.signs() {
+			signers = append(signers, k.signer)
+		}
+	}
+	if len(signers) == 0 {
+		return nil, errors.New("no active key to sign the keyring with")
+	}
+	modTime := keyringTime(keys)
+
+	var data bytes.Buffer
+	gz := gzip.NewWriter(&data)
+	tw := tar.NewWriter(gz)
+	for _, dir := range []string{"etc/", "etc/apk/", "etc/apk/keys/"} {
+		err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: dir, Mode: 0755, ModTime: modTime, Uname: "root", Gname: "root"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	size := 0
+	for _, k := range keys {
+		// retired keys don't sign anything any more, and apk only checks index signatures, so
+		// there's nothing left for clients to trust them for. inactive keys do go in, they have
+		// to be trusted before they start signing
+		if k.meta.Status == Retired {
+			continue
+		}
+		pub, err := k.publicKeyPEM()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode public key %s: %w", k.name, err)
+		}
+		sum := sha1.Sum(pub)
+		err = tw.WriteHeader(&tar.Header{
+			Name: "etc/apk/keys/" + k.publicKeyName(), Mode: 0644, Size: int64(len(pub)), ModTime: modTime, Uname: "root", Gname: "root",
+			// apk checks each file against this
+			PAXRecords: map[string]string{"APK-TOOLS.checksum.SHA1": hex.EncodeToString(sum[:])},
+		})
+		if err != nil {
+			return nil, err
+		}
+		_, err = tw.Write(pub)
+		if err != nil {
+			return nil, err
+		}
+		size += len(pub)
+	}
+	err := tw.Close()
+	if err != nil {
+		return nil, err
+	}
+	err = gz.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	dataHash := sha256.Sum256(data.Bytes())
+	pkgInfo := fmt.Sprintf(`# Generated by packages
+pkgname = %[1]s
+pkgver = %[2]s-r0
+pkgdesc = Public keys for the %[3]s %[4]s repositories
+url =
+builddate = %[5]d
+packager = packages
+size = %[6]d
+arch = noarch
+origin = %[1]s
+license = MIT
+datahash = %[7]s
+`, keyringName(org), modTime.Format(keyringVersionFormat), org, distro, modTime.Unix(), size, hex.EncodeToString(dataHash[:]))
+
+	var control bytes.Buffer
+	gz = gzip.NewWriter(&control)
+	tw = tar.NewWriter(gz)
+	err = tw.WriteHeader(&tar.Header{Name: ".PKGINFO", Mode: 0644, Size: int64(len(pkgInfo)), ModTime: modTime, Uname: "root", Gname: "root"})
+	if err != nil {
+		return nil, err
+	}
+	_, err = tw.Write([]byte(pkgInfo))
+	if err != nil {
+		return nil, err
+	}
+	// like the signatures, the control tar runs straight into the next stream
+	err = tw.Flush()
+	if err != nil {
+		return nil, err
+	}
+	err = gz.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := signArchive(control.Bytes(), signers)
+	if err != nil {
+		return nil, err
+	}
+	return append(signed, data.Bytes()...), nil
+}
+
+// writeKeyring - build and publish the keyring package for a distro's keys
+func writeKeyring(basedir, org, distro string, keys []distroKey) error {
+	pkg, err := buildKeyring(org, distro, keys)
+	if err != nil {
+		return fmt.Errorf("failed to build keyring package: %w", err)
+	}
+	ctx := context.Background()
+	cfs := afs.New()
+	err = cfs.Init(ctx, basedir)
+	if err == nil {
+		err = cfs.Upload(ctx, keyringURI(basedir, org, distro), 0644, bytes.NewReader(pkg))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write keyring package: %w", err)
+	}
+	log.Info().Str("org", org).Str("distro", distro).Str("version", keyringTime(keys).Format(keyringVersionFormat)).Msg("rebuilt keyring package")
+	return nil
+}
+
+// rebuildKeyring - rebuild and publish the keyring package after the keys changed
+// errors are only logged, the key change itself has already happened by now
+func rebuildKeyring(basedir, org, distro string, keys []distroKey) {
+	err := writeKeyring(basedir, org, distro, keys)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to rebuild keyring")
+	}
+}
+
+// publishKeys - publish every one of a distro's public keys and rebuild its keyring, for trees
+// from before either was done automatically, or keys that were copied in by hand
+func publishKeys(basedir, org, distro string) error {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
+	keys, err := loadDistroKeys(basedir, org, distro)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return errKeyNotFound
+	}
+	for _, k := range keys {
+		err := publishPublicKey(basedir, org, distro, k)
+		if err != nil {
+			return fmt.Errorf("failed to publish public key %s: %w", k.name, err)
+		}
+	}
+	return writeKeyring(basedir, org, distro, keys)
+}
+
+// GetKeyring - download the keyring package for a distro
+func (p *PkgRepoAPI) GetKeyring(ctx echo.Context, org, distro string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "no keyring"})
+	}
+	c := context.Background()
+	cfs := afs.New()
+	uri := keyringURI(PackageBaseDirectory, org, distro)
+	ex, err := cfs.Exists(c, uri)
+	if err == nil && !ex {
+		// keys from before there was a keyring, or copied in by hand, need a POST to build it
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "the keyring hasn't been built yet"})
+	}
+	data, err := cfs.DownloadWithURL(c, uri)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to read keyring package")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to read keyring package"})
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", keyringName(org)+".apk"))
+	return ctx.Blob(http.StatusOK, "application/octet-stream", data)
+}
+
+// RebuildKeyring - publish every public key for a distro and rebuild its keyring
+func (p *PkgRepoAPI) RebuildKeyring(ctx echo.Context, org, distro string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	err := publishKeys(PackageBaseDirectory, org, distro)
+	if errors.Is(err, errKeyNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "the distribution doesn't have any keys"})
+	}
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to rebuild keyring")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to rebuild keyring"})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
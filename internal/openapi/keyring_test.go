@@ -0,0 +1,102 @@
+package api
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/afs"
+)
+
+func TestKeyring(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-keyring-*")
+	if err != nil {
+		t.Fatal("failed to create testKeyring tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testKeyring tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+	keyringPath := filepath.Join(tmpDir, "static", "testorg", "alpine", "testorg-keys.apk")
+
+	first, err := createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	assert.False(t, first.meta.Activated.IsZero())
+	assert.FileExists(t, keyringPath)
+
+	// the keyring picks up new keys, and is signed by the old one too while it's rotating
+	second, err := createDistroKey(basedir, "testorg", "alpine", minKeyBits, true)
+	assert.NoError(t, err)
+	data, err := os.ReadFile(keyringPath)
+	assert.NoError(t, err)
+
+	names, contents := readSignedArchive(t, data)
+	assert.Equal(t, []string{
+		".SIGN.RSA." + first.publicKeyName(),
+		".SIGN.RSA." + second.publicKeyName(),
+		".PKGINFO",
+		"etc/",
+		"etc/apk/",
+		"etc/apk/keys/",
+		"etc/apk/keys/" + first.publicKeyName(),
+		"etc/apk/keys/" + second.publicKeyName(),
+	}, names)
+	pub, err := second.publicKeyPEM()
+	assert.NoError(t, err)
+	assert.Equal(t, pub, contents["etc/apk/keys/"+second.publicKeyName()])
+	pkgInfo := string(contents[".PKGINFO"])
+	assert.Contains(t, pkgInfo, "pkgname = testorg-keys\n")
+	assert.Contains(t, pkgInfo, "arch = noarch\n")
+
+	// the signatures are for the control stream, and the data stream matches the datahash
+	// the streams are separate gzip members, so split them up the same way they were written
+	members, err := gzipMembers(data)
+	assert.NoError(t, err)
+	assert.Len(t, members, 4)
+	sigs, control := members[:2], members[2]
+	sum := sha256.Sum256(members[3])
+	assert.Contains(t, pkgInfo, "datahash = "+hex.EncodeToString(sum[:])+"\n")
+	digest := sha1.Sum(control)
+	keys, err := loadDistroKeys(basedir, "testorg", "alpine")
+	assert.NoError(t, err)
+	for i, k := range keys {
+		_, sigContents := readSignedArchive(t, sigs[i])
+		err = rsa.VerifyPKCS1v15(k.public, crypto.SHA1, digest[:], sigContents[".SIGN.RSA."+k.publicKeyName()])
+		assert.NoError(t, err)
+	}
+
+	// a new key that isn't signing yet goes in, so clients trust it before it does
+	third, err := createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	assert.Equal(t, Inactive, third.meta.Status)
+	data, err = afs.New().DownloadWithURL(t.Context(), keyringURI(basedir, "testorg", "alpine"))
+	assert.NoError(t, err)
+	names, _ = readSignedArchive(t, data)
+	assert.Contains(t, names, "etc/apk/keys/"+third.publicKeyName())
+
+	// retiring a key takes it out of the keyring, clients stop trusting it when they upgrade
+	k, err := setDistroKeyStatus(basedir, "testorg", "alpine", first.name, Retired)
+	assert.NoError(t, err)
+	sk := signingKeyFrom(k)
+	assert.NotNil(t, sk.ValidFrom)
+	assert.NotNil(t, sk.ValidUntil)
+	data, err = afs.New().DownloadWithURL(t.Context(), keyringURI(basedir, "testorg", "alpine"))
+	assert.NoError(t, err)
+	names, _ = readSignedArchive(t, data)
+	assert.Equal(t, ".SIGN.RSA."+second.publicKeyName(), names[0])
+	all := strings.Join(names, " ")
+	assert.NotContains(t, all, first.publicKeyName())
+	assert.Contains(t, all, "etc/apk/keys/"+second.publicKeyName())
+	assert.Contains(t, all, "etc/apk/keys/"+third.publicKeyName())
+}
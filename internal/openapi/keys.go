@@ -0,0 +1,599 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gopkg.in/yaml.v3"
+)
+
+// signing keys live in config/<org>/<distro>/<name>.rsa with their status in keys.yaml next to
+// them, the public keys get published as static/<org>/<distro>/<name>.rsa.pub
+// keys that aren't in keys.yaml (e.g. ones that were copied in by hand) are inactive, unless
+// nothing is active, then the last one is, which is how it worked before there was a keys.yaml
+// a key in keys.yaml can also be signed with by a command or an ssh-agent instead, then there's
+// only a <name>.rsa.pub in the config dir, e.g.
+//
+//	keys:
+//	  atlascloud-6ad64ad8:
+//	    status: active
+//	    agent: /run/pkgs/agent.sock
+//	  atlascloud-5f0c1a2b:
+//	    status: rotating
+//	    passphraseFile: /run/secrets/atlascloud-5f0c1a2b
+
+const (
+	keyMetadataFile = "keys.yaml"
+	defaultKeyBits  = 4096
+	minKeyBits      = 2048
+	maxKeyBits      = 8192
+)
+
+var (
+	errKeyNotFound = errors.New("signing key not found")
+	errKeyConflict = errors.New("signing key change not allowed")
+)
+
+// keysLock - serializes changes to the keys of every distro, they're rare enough that one lock
+// is plenty
+var keysLock sync.Mutex
+
+type keyMetadata struct {
+	Status  SigningKeyStatus `yaml:"status"`
+	Created time.Time        `yaml:"created"`
+	// Activated/Retired - when the key was first made active and when it was retired
+	Activated time.Time `yaml:"activated,omitempty"`
+	Retired   time.Time `yaml:"retired,omitempty"`
+	// PassphraseEnv/PassphraseFile - where the passphrase for an encrypted <name>.rsa comes from
+	PassphraseEnv  string `yaml:"passphraseEnv,omitempty"`
+	PassphraseFile string `yaml:"passphraseFile,omitempty"`
+	// Command - sign by piping the index through this instead of using <name>.rsa
+	Command []string `yaml:"command,omitempty"`
+	// Agent - sign with the matching key in the ssh-agent on this socket instead of using <name>.rsa
+	Agent string `yaml:"agent,omitempty"`
+}
+
+// external - whether the private key lives somewhere else
+func (m keyMetadata) external() bool {
+	return len(m.Command) > 0 || m.Agent != ""
+}
+
+type keyMetadataFileContents struct {
+	Keys map[string]keyMetadata `yaml:"keys"`
+}
+
+// distroKey - a signing key along with its status
+type distroKey struct {
+	name string
+	// file - the private key's filename, the signature (and public key) is named <file>.pub
+	file   string
+	public *rsa.PublicKey
+	signer Signer
+	meta   keyMetadata
+}
+
+func (k distroKey) publicKeyName() string {
+	return k.file + ".pub"
+}
+
+func (k distroKey) signs() bool {
+	return k.meta.Status == Active || k.meta.Status == Rotating
+}
+
+func (k distroKey) publicKeyPEM() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.public)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// signingKeyFrom - convert a key into the API representation
+func signingKeyFrom(k distroKey) SigningKey {
+	sk := SigningKey{
+		Name:      k.name,
+		Status:    k.meta.Status,
+		PublicKey: k.publicKeyName(),
+	}
+	// keys that have never been published don't have a public key to describe yet
+	if k.public != nil {
+		bits := k.public.N.BitLen()
+		sk.Bits = &bits
+		der, err := x509.MarshalPKIXPublicKey(k.public)
+		if err == nil {
+			sum := sha256.Sum256(der)
+			fingerprint := hex.EncodeToString(sum[:])
+			sk.Fingerprint = &fingerprint
+		}
+	}
+	if !k.meta.Created.IsZero() {
+		created := k.meta.Created.UTC()
+		sk.Created = &created
+	}
+	if !k.meta.Activated.IsZero() {
+		from := k.meta.Activated.UTC()
+		sk.ValidFrom = &from
+	}
+	if !k.meta.Retired.IsZero() {
+		until := k.meta.Retired.UTC()
+		sk.ValidUntil = &until
+	}
+	return sk
+}
+
+// loadDistroKeyInfo - a distro's keys and their status, sorted by name, without reading any of
+// the private keys: the public halves come from what's been published in static/<org>/<distro>/
+// (or the config dir for external keys), so this is all the endpoints that don't need a token get
+// to use. keys that were copied in by hand and never published don't have a public key yet
+func loadDistroKeyInfo(basedir, org, distro string) ([]distroKey, error) {
+	ctx := context.Background()
+	// this looks like it's hardcoding the scheme, but it's really just trying to duplicate the logic that afs.List() uses
+	configURI := url.Normalize(url.JoinUNC(basedir, "config", org, distro), file.Scheme)
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+	ex, err := cfs.Exists(ctx, configURI)
+	if err != nil || !ex {
+		return nil, err
+	}
+
+	var meta keyMetadataFileContents
+	metaURI := url.JoinUNC(configURI, keyMetadataFile)
+	ex, err = cfs.Exists(ctx, metaURI)
+	if err != nil {
+		return nil, err
+	}
+	if ex {
+		data, err := cfs.DownloadWithURL(ctx, metaURI)
+		if err != nil {
+			return nil, err
+		}
+		err = yaml.Unmarshal(data, &meta)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", metaURI, err)
+		}
+	}
+
+	list, err := cfs.List(ctx, configURI)
+	if err != nil {
+		return nil, err
+	}
+	var keys []distroKey
+	hasActive := false
+	lastUnknown := -1
+	for _, f := range list {
+		// the afs matcher thing doesn't seem to work, so we have to find it the hard way
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".rsa") {
+			continue
+		}
+		name := strings.TrimSuffix(f.Name(), ".rsa")
+		m, ok := meta.Keys[name]
+		if ok && m.external() {
+			continue
+		}
+		k := distroKey{name: name, file: f.Name()}
+		if ok {
+			k.meta = m
+		} else {
+			k.meta = keyMetadata{Status: Inactive, Created: f.ModTime()}
+			lastUnknown = len(keys)
+		}
+		if k.meta.Status == Active {
+			hasActive = true
+		}
+		keys = append(keys, k)
+	}
+	for name, m := range meta.Keys {
+		if !m.external() {
+			continue
+		}
+		if m.Status == Active {
+			hasActive = true
+		}
+		keys = append(keys, distroKey{name: name, file: name + ".rsa", meta: m})
+	}
+	if !hasActive && lastUnknown >= 0 {
+		keys[lastUnknown].meta.Status = Active
+	}
+
+	for i, k := range keys {
+		uris := []string{url.JoinUNC(basedir, "static", org, distro, k.publicKeyName())}
+		if k.meta.external() {
+			uris = append(uris, url.JoinUNC(configURI, k.publicKeyName()))
+		}
+		for _, uri := range uris {
+			ex, err := cfs.Exists(ctx, uri)
+			if err != nil {
+				return nil, err
+			}
+			if !ex {
+				continue
+			}
+			data, err := cfs.DownloadWithURL(ctx, uri)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read public key for %s: %w", k.name, err)
+			}
+			keys[i].public, err = parsePublicKey(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key for %s: %w", k.name, err)
+			}
+			break
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].name < keys[j].name })
+	return keys, nil
+}
+
+// loadDistroKeys - load every key for a distro along with its status and a signer for it, sorted
+// by name. this reads (and decrypts) the private keys, so it's only for the paths that sign or
+// change keys
+func loadDistroKeys(basedir, org, distro string) ([]distroKey, error) {
+	keys, err := loadDistroKeyInfo(basedir, org, distro)
+	if err != nil || len(keys) == 0 {
+		return keys, err
+	}
+	ctx := context.Background()
+	configURI := url.JoinUNC(basedir, "config", org, distro)
+	cfs := afs.New()
+	for i, k := range keys {
+		if k.meta.external() {
+			// the signature is checked against what's in the config dir, not what was published
+			data, err := cfs.DownloadWithURL(ctx, url.JoinUNC(configURI, k.publicKeyName()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read public key for %s: %w", k.name, err)
+			}
+			k.public, err = parsePublicKey(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key for %s: %w", k.name, err)
+			}
+			if len(k.meta.Command) > 0 {
+				k.signer = NewCommandSigner(k.publicKeyName(), k.public, k.meta.Command)
+			} else {
+				k.signer = NewAgentSigner(k.publicKeyName(), k.meta.Agent, k.public)
+			}
+			keys[i] = k
+			continue
+		}
+		data, err := cfs.DownloadWithURL(ctx, url.JoinUNC(configURI, k.file))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rsa key %s: %w", k.file, err)
+		}
+		passphrase, err := KeyPassphrase(k.meta.PassphraseEnv, k.meta.PassphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the passphrase for %s: %w", k.file, err)
+		}
+		key, err := parsePrivateKey(data, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rsa key %s: %w", k.file, err)
+		}
+		keys[i].public = &key.PublicKey
+		keys[i].signer = NewKeySigner(key, k.publicKeyName())
+	}
+	return keys, nil
+}
+
+// saveKeyMetadata - write keys.yaml for a distro
+func saveKeyMetadata(basedir, org, distro string, keys []distroKey) error {
+	ctx := context.Background()
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return err
+	}
+	meta := keyMetadataFileContents{Keys: map[string]keyMetadata{}}
+	for _, k := range keys {
+		meta.Keys[k.name] = k.meta
+	}
+	data, err := yaml.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return cfs.Upload(ctx, url.JoinUNC(basedir, "config", org, distro, keyMetadataFile), 0644, bytes.NewReader(data))
+}
+
+// publishPublicKey - write the public half of a key into static/<org>/<distro>/
+func publishPublicKey(basedir, org, distro string, k distroKey) error {
+	ctx := context.Background()
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return err
+	}
+	pub, err := k.publicKeyPEM()
+	if err != nil {
+		return err
+	}
+	return cfs.Upload(ctx, url.JoinUNC(basedir, "static", org, distro, k.publicKeyName()), 0644, bytes.NewReader(pub))
+}
+
+// createDistroKey - generate and publish a new key for a distro
+// new keys are inactive unless activate is set, so clients can pick up the public key before
+// anything is signed with it
+func createDistroKey(basedir, org, distro string, bits int, activate bool) (distroKey, error) {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
+	keys, err := loadDistroKeys(basedir, org, distro)
+	if err != nil {
+		return distroKey{}, err
+	}
+
+	// the same <name>-<hex timestamp> naming abuild-keygen uses, bumped if two keys get made in
+	// the same second
+	now := time.Now().UTC()
+	stamp := now.Unix()
+	name := fmt.Sprintf("%s-%x", org, stamp)
+	for slices.ContainsFunc(keys, func(k distroKey) bool { return k.name == name }) {
+		stamp++
+		name = fmt.Sprintf("%s-%x", org, stamp)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return distroKey{}, err
+	}
+	k := distroKey{name: name, file: name + ".rsa", public: &key.PublicKey, signer: NewKeySigner(key, name+".rsa.pub"), meta: keyMetadata{Status: Inactive, Created: now}}
+
+	ctx := context.Background()
+	cfs := afs.New()
+	err = cfs.Init(ctx, basedir)
+	if err != nil {
+		return distroKey{}, err
+	}
+	priv := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	err = cfs.Upload(ctx, url.JoinUNC(basedir, "config", org, distro, k.file), 0600, bytes.NewReader(priv))
+	if err != nil {
+		return distroKey{}, fmt.Errorf("failed to write private key: %w", err)
+	}
+	err = publishPublicKey(basedir, org, distro, k)
+	if err != nil {
+		return distroKey{}, fmt.Errorf("failed to publish public key: %w", err)
+	}
+
+	keys = append(keys, k)
+	if activate || len(keys) == 1 {
+		// the first key for a distro is the one that signs things, otherwise there's nothing to rotate from
+		keys = activateKey(keys, name)
+		k = keys[len(keys)-1]
+	}
+	err = saveKeyMetadata(basedir, org, distro, keys)
+	if err != nil {
+		return distroKey{}, fmt.Errorf("failed to save key metadata: %w", err)
+	}
+	rebuildKeyring(basedir, org, distro, keys)
+	log.Info().Str("org", org).Str("distro", distro).Str("key", name).Str("status", string(k.meta.Status)).Msg("created signing key")
+	return k, nil
+}
+
+// activateKey - make a key active, the previously active key moves to rotating so both sign
+// until the old one is retired
+func activateKey(keys []distroKey, name string) []distroKey {
+	for i := range keys {
+		switch {
+		case keys[i].name == name:
+			keys[i].meta.Status = Active
+			keys[i].meta.Retired = time.Time{}
+			if keys[i].meta.Activated.IsZero() {
+				keys[i].meta.Activated = time.Now().UTC()
+			}
+		case keys[i].meta.Status == Active:
+			keys[i].meta.Status = Rotating
+		}
+	}
+	return keys
+}
+
+// setDistroKeyStatus - change the status of one of a distro's keys
+func setDistroKeyStatus(basedir, org, distro, name string, status SigningKeyStatus) (distroKey, error) {
+	keysLock.Lock()
+	defer keysLock.Unlock()
+
+	keys, err := loadDistroKeys(basedir, org, distro)
+	if err != nil {
+		return distroKey{}, err
+	}
+	idx := -1
+	for i, k := range keys {
+		if k.name == name {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return distroKey{}, errKeyNotFound
+	}
+
+	switch status {
+	case Active:
+		keys = activateKey(keys, name)
+	case Rotating, Inactive, Retired:
+		if keys[idx].meta.Status == Active && status != Active {
+			return distroKey{}, fmt.Errorf("%w: %s is the active key, activate another key first", errKeyConflict, name)
+		}
+		keys[idx].meta.Status = status
+		if status == Retired {
+			keys[idx].meta.Retired = time.Now().UTC()
+		} else {
+			keys[idx].meta.Retired = time.Time{}
+		}
+	default:
+		return distroKey{}, fmt.Errorf("%w: unknown status %q", errKeyConflict, status)
+	}
+
+	// keys that were copied in by hand may never have been published
+	err = publishPublicKey(basedir, org, distro, keys[idx])
+	if err != nil {
+		return distroKey{}, fmt.Errorf("failed to publish public key: %w", err)
+	}
+	err = saveKeyMetadata(basedir, org, distro, keys)
+	if err != nil {
+		return distroKey{}, fmt.Errorf("failed to save key metadata: %w", err)
+	}
+	rebuildKeyring(basedir, org, distro, keys)
+	log.Info().Str("org", org).Str("distro", distro).Str("key", name).Str("status", string(status)).Msg("updated signing key")
+	return keys[idx], nil
+}
+
+// indexSigningKeys - the keys that sign a distro's indexes, the active one first
+func indexSigningKeys(basedir, org, distro string) ([]distroKey, error) {
+	keys, err := loadDistroKeys(basedir, org, distro)
+	if err != nil {
+		return nil, err
+	}
+	var result []distroKey
+	for _, k := range keys {
+		if k.signs() {
+			result = append(result, k)
+		}
+	}
+	if len(result) == 0 {
+		return nil, errors.New("no RSA key found")
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].meta.Status == Active && result[j].meta.Status != Active
+	})
+	return result, nil
+}
+
+// reservedDistros - what else lives in config/<org>, a distro with one of these names would put its keys
+// in with the org's tokens or audit log
+var reservedDistros = []string{"tokens", auditDir}
+
+// validDistro - whether org and distro are safe to build config and static paths from
+func validDistro(org, distro string) bool {
+	return validPathSegments(org, distro) && !slices.Contains(reservedDistros, distro)
+}
+
+// ListSigningKeys - list the signing keys for a distro
+func (p *PkgRepoAPI) ListSigningKeys(ctx echo.Context, org, distro string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	keys, err := loadDistroKeyInfo(PackageBaseDirectory, org, distro)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to load signing keys")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to load signing keys"})
+	}
+	result := []SigningKey{}
+	for _, k := range keys {
+		result = append(result, signingKeyFrom(k))
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateSigningKey - generate a new signing key for a distro
+func (p *PkgRepoAPI) CreateSigningKey(ctx echo.Context, org, distro string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	var req NewSigningKey
+	err := ctx.Bind(&req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid request"})
+	}
+	bits := defaultKeyBits
+	if req.Bits != nil {
+		bits = *req.Bits
+	}
+	if bits < minKeyBits || bits > maxKeyBits {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid key size"})
+	}
+
+	k, err := createDistroKey(PackageBaseDirectory, org, distro, bits, req.Activate != nil && *req.Activate)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to create signing key")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to create signing key"})
+	}
+	return ctx.JSON(http.StatusOK, signingKeyFrom(k))
+}
+
+// GetSigningKey - return one of a distro's signing keys
+func (p *PkgRepoAPI) GetSigningKey(ctx echo.Context, org, distro, name string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	keys, err := loadDistroKeyInfo(PackageBaseDirectory, org, distro)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to load signing keys")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to load signing keys"})
+	}
+	for _, k := range keys {
+		if k.name == name {
+			return ctx.JSON(http.StatusOK, signingKeyFrom(k))
+		}
+	}
+	return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: errKeyNotFound.Error()})
+}
+
+// UpdateSigningKey - change the status of a signing key
+func (p *PkgRepoAPI) UpdateSigningKey(ctx echo.Context, org, distro, name string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	var req SigningKeyUpdate
+	err := ctx.Bind(&req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid request"})
+	}
+
+	k, err := setDistroKeyStatus(PackageBaseDirectory, org, distro, name, req.Status)
+	switch {
+	case errors.Is(err, errKeyNotFound):
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	case errors.Is(err, errKeyConflict):
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	case err != nil:
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Str("key", name).Msg("failed to update signing key")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to update signing key"})
+	}
+	return ctx.JSON(http.StatusOK, signingKeyFrom(k))
+}
+
+// GetSigningKeyPublicKey - download the public half of a signing key
+func (p *PkgRepoAPI) GetSigningKeyPublicKey(ctx echo.Context, org, distro, name string) error {
+	if !validDistro(org, distro) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid org or distro"})
+	}
+	keys, err := loadDistroKeyInfo(PackageBaseDirectory, org, distro)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("distro", distro).Msg("failed to load signing keys")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to load signing keys"})
+	}
+	for _, k := range keys {
+		if k.name != name {
+			continue
+		}
+		if k.public == nil {
+			return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: name + " hasn't been published"})
+		}
+		pub, err := k.publicKeyPEM()
+		if err != nil {
+			log.Error().Err(err).Str("org", org).Str("distro", distro).Str("key", name).Msg("failed to encode public key")
+			return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to encode public key"})
+		}
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", k.publicKeyName()))
+		return ctx.Blob(http.StatusOK, "application/x-pem-file", pub)
+	}
+	return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: errKeyNotFound.Error()})
+}
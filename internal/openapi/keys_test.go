@@ -0,0 +1,203 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKeyRotation(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-keys-*")
+	if err != nil {
+		t.Fatal("failed to create testSigningKeyRotation tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testSigningKeyRotation tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+
+	// the first key is active straight away
+	first, err := createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	assert.Equal(t, Active, first.meta.Status)
+	assert.FileExists(t, filepath.Join(tmpDir, "config", "testorg", "alpine", first.name+".rsa"))
+	assert.FileExists(t, filepath.Join(tmpDir, "static", "testorg", "alpine", first.name+".rsa.pub"))
+
+	// later ones aren't until they're activated
+	second, err := createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.name, second.name)
+	assert.Equal(t, Inactive, second.meta.Status)
+
+	keys, err := indexSigningKeys(basedir, "testorg", "alpine")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, first.name, keys[0].name)
+
+	// activating the new key keeps the old one signing
+	_, err = setDistroKeyStatus(basedir, "testorg", "alpine", second.name, Active)
+	assert.NoError(t, err)
+	keys, err = indexSigningKeys(basedir, "testorg", "alpine")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, second.name, keys[0].name)
+	assert.Equal(t, Rotating, keys[1].meta.Status)
+
+	_, err = setDistroKeyStatus(basedir, "testorg", "alpine", second.name, Retired)
+	assert.True(t, errors.Is(err, errKeyConflict))
+	_, err = setDistroKeyStatus(basedir, "testorg", "alpine", "nope", Retired)
+	assert.True(t, errors.Is(err, errKeyNotFound))
+
+	// retiring the old key stops it signing, but leaves the public key published
+	_, err = setDistroKeyStatus(basedir, "testorg", "alpine", first.name, Retired)
+	assert.NoError(t, err)
+	keys, err = indexSigningKeys(basedir, "testorg", "alpine")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, second.name, keys[0].name)
+	assert.FileExists(t, filepath.Join(tmpDir, "static", "testorg", "alpine", first.name+".rsa.pub"))
+}
+
+func TestLegacySigningKey(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-keys-*")
+	if err != nil {
+		t.Fatal("failed to create testLegacySigningKey tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testLegacySigningKey tmpDir", err)
+		}
+	}()
+
+	// a key copied in by hand, without a keys.yaml
+	key, err := rsa.GenerateKey(rand.Reader, minKeyBits)
+	if err != nil {
+		t.Fatal("failed to generate test key", err)
+	}
+	dir := filepath.Join(tmpDir, "config", "testorg", "alpine")
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test config dir", err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	err = os.WriteFile(filepath.Join(dir, "someone@example.com-1234.rsa"), pemData, 0600)
+	if err != nil {
+		t.Fatal("failed to write test key", err)
+	}
+
+	keys, err := indexSigningKeys("file://"+tmpDir, "testorg", "alpine")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, "someone@example.com-1234.rsa.pub", keys[0].publicKeyName())
+}
+
+func TestPublicKeyEndpoints(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-keys-*")
+	if err != nil {
+		t.Fatal("failed to create testPublicKeyEndpoints tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testPublicKeyEndpoints tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+
+	// an encrypted key whose passphrase the server doesn't have right now, and one that was
+	// copied in by hand and never published
+	const org, distro = "testorg", "alpine"
+	configDir := filepath.Join(tmpDir, "config", org, distro)
+	staticDir := filepath.Join(tmpDir, "static", org, distro)
+	assert.NoError(t, os.MkdirAll(configDir, 0755))
+	assert.NoError(t, os.MkdirAll(staticDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(configDir, "encrypted.rsa"), []byte(testEncryptedKey), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(configDir, keyMetadataFile), []byte("keys:\n  encrypted:\n    status: active\n    passphraseEnv: TEST_PUBLIC_KEYS_PASSPHRASE\n"), 0644))
+	key, err := parsePrivateKey([]byte(testEncryptedKey), []byte("hunter2"))
+	assert.NoError(t, err)
+	pub, err := distroKey{public: &key.PublicKey}.publicKeyPEM()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(staticDir, "encrypted.rsa.pub"), pub, 0644))
+	legacy, err := rsa.GenerateKey(rand.Reader, minKeyBits)
+	if err != nil {
+		t.Fatal("failed to generate test key", err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(legacy)})
+	assert.NoError(t, os.WriteFile(filepath.Join(configDir, "legacy.rsa"), pemData, 0600))
+
+	e := echo.New()
+	papi := &PkgRepoAPI{}
+	get := func(f func(echo.Context) error) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		assert.NoError(t, f(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)))
+		return rec
+	}
+
+	// none of these need the private keys
+	rec := get(func(c echo.Context) error { return papi.ListSigningKeys(c, org, distro) })
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var keys []SigningKey
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &keys))
+	if assert.Len(t, keys, 2) {
+		assert.Equal(t, "encrypted", keys[0].Name)
+		assert.Equal(t, Active, keys[0].Status)
+		assert.NotNil(t, keys[0].Fingerprint)
+		assert.Equal(t, Inactive, keys[1].Status)
+		assert.Nil(t, keys[1].Fingerprint)
+	}
+	rec = get(func(c echo.Context) error { return papi.GetSigningKeyPublicKey(c, org, distro, "encrypted") })
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, pub, rec.Body.Bytes())
+	rec = get(func(c echo.Context) error { return papi.GetSigningKeyPublicKey(c, org, distro, "legacy") })
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	// and the keyring isn't built on demand
+	rec = get(func(c echo.Context) error { return papi.GetKeyring(c, org, distro) })
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoFileExists(t, filepath.Join(staticDir, "testorg-keys.apk"))
+
+	// building it does
+	rebuild := func() int {
+		rec := httptest.NewRecorder()
+		assert.NoError(t, papi.RebuildKeyring(e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec), org, distro))
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusInternalServerError, rebuild())
+	t.Setenv("TEST_PUBLIC_KEYS_PASSPHRASE", "hunter2")
+	assert.Equal(t, http.StatusNoContent, rebuild())
+	rec = get(func(c echo.Context) error { return papi.GetKeyring(c, org, distro) })
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = get(func(c echo.Context) error { return papi.GetSigningKeyPublicKey(c, org, distro, "legacy") })
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// keys can't be made somewhere that isn't a distro, like next to the org's tokens
+	for _, bad := range []string{"tokens", auditDir, ".."} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		assert.NoError(t, papi.CreateSigningKey(e.NewContext(req, rec), org, bad))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, bad)
+	}
+	assert.NoDirExists(t, filepath.Join(tmpDir, "config", org, "tokens"))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "config", org, keyMetadataFile))
+}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+)
+
+// metrics about the repos themselves, as opposed to the HTTP metrics echo-contrib provides
+// they're all in the default registry, which is what echo-contrib's /metrics serves
+
+// storageScanInterval - how long the storage usage is cached for, walking the whole tree on every
+// scrape would be too slow on a big server
+const storageScanInterval = 5 * time.Minute
+
+var repoLabels = []string{"org", "distro", "version", "repo", "arch"}
+
+var (
+	uploadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "uploads_total",
+		Help:      "Packages uploaded",
+	}, []string{"org", "distro", "repo", "arch"})
+	uploadBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "upload_bytes_total",
+		Help:      "Bytes of packages uploaded",
+	}, []string{"org", "distro", "repo", "arch"})
+	uploadRejectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "upload_rejections_total",
+		Help:      "Uploads that were rejected, by reason",
+	}, []string{"org", "reason"})
+
+	indexDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "packages",
+		Name:      "index_generation_duration_seconds",
+		Help:      "How long generating an APKINDEX took",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 14),
+	}, repoLabels)
+	indexFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "index_generation_failures_total",
+		Help:      "APKINDEX generations that failed",
+	}, repoLabels)
+	indexPackages = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "packages",
+		Name:      "index_packages",
+		Help:      "Packages in the last APKINDEX generated",
+	}, repoLabels)
+	indexParseFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "index_package_parse_failures_total",
+		Help:      "Packages that couldn't be parsed (and were left out of the index) while generating an APKINDEX",
+	}, repoLabels)
+	indexLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "packages",
+		Name:      "index_last_success_timestamp_seconds",
+		Help:      "When an APKINDEX was last generated successfully",
+	}, repoLabels)
+	indexQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "packages",
+		Name:      "index_queue_depth",
+		Help:      "APKINDEX generations that have been started and haven't finished",
+	})
+
+	proxyRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "proxy_requests_total",
+		Help:      "Files requested from proxy repos, by whether they were cached",
+	}, []string{"org", "result"})
+
+	authFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "packages",
+		Name:      "auth_failures_total",
+		Help:      "Requests rejected because of a missing or invalid token",
+	}, []string{"org", "reason"})
+)
+
+func init() {
+	prometheus.MustRegister(newStorageCollector())
+}
+
+// metricOrg - an org name that's safe to use as a label, anything can be put in the URL so orgs
+// that don't exist all get lumped together rather than creating a new series each
+func metricOrg(org string) string {
+	if org == "" || !orgExists(org) {
+		return "unknown"
+	}
+	return org
+}
+
+// observeUpload - count an uploaded package
+func observeUpload(org, distro, repo, arch string, size int64) {
+	uploadsTotal.WithLabelValues(org, distro, repo, arch).Inc()
+	uploadBytesTotal.WithLabelValues(org, distro, repo, arch).Add(float64(size))
+}
+
+// observeUploadRejection - count an upload that was turned away
+func observeUploadRejection(org, reason string) {
+	uploadRejectionsTotal.WithLabelValues(metricOrg(org), reason).Inc()
+}
+
+// ObserveAuthFailure - count a request with a missing or bad token, the auth check lives in the
+// server's main so this is exported for it
+func ObserveAuthFailure(org, reason string) {
+	authFailuresTotal.WithLabelValues(metricOrg(org), reason).Inc()
+}
+
+// observeProxyRequest - count a file served by a proxy repo, result is hit, miss or error
+func observeProxyRequest(org, result string) {
+	proxyRequestsTotal.WithLabelValues(org, result).Inc()
+}
+
+// observeIndexGeneration - record how an index generation went
+func observeIndexGeneration(loc RepoLocation, took time.Duration, err error) {
+	labels := []string{loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch}
+	indexDuration.WithLabelValues(labels...).Observe(took.Seconds())
+	if err != nil {
+		indexFailuresTotal.WithLabelValues(labels...).Inc()
+		return
+	}
+	indexLastSuccess.WithLabelValues(labels...).SetToCurrentTime()
+}
+
+// repoUsage - what a repo dir takes up
+type repoUsage struct {
+	bytes    int64
+	packages int
+}
+
+// storageCollector - reports the size of every repo on disk
+type storageCollector struct {
+	mu      sync.Mutex
+	scanned time.Time
+	usage   map[RepoLocation]repoUsage
+
+	bytesDesc    *prometheus.Desc
+	packagesDesc *prometheus.Desc
+}
+
+func newStorageCollector() *storageCollector {
+	return &storageCollector{
+		bytesDesc:    prometheus.NewDesc("packages_storage_bytes", "Bytes of packages stored in a repo", repoLabels, nil),
+		packagesDesc: prometheus.NewDesc("packages_storage_packages", "Packages stored in a repo", repoLabels, nil),
+	}
+}
+
+func (c *storageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.bytesDesc
+	ch <- c.packagesDesc
+}
+
+func (c *storageCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// PackageBaseDirectory isn't set until the server starts
+	if PackageBaseDirectory == "" {
+		return
+	}
+	if time.Since(c.scanned) > storageScanInterval {
+		usage, err := scanStorage(PackageBaseDirectory)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to scan storage usage")
+		} else {
+			c.usage = usage
+			c.scanned = time.Now()
+		}
+	}
+	for loc, u := range c.usage {
+		labels := []string{loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch}
+		ch <- prometheus.MustNewConstMetric(c.bytesDesc, prometheus.GaugeValue, float64(u.bytes), labels...)
+		ch <- prometheus.MustNewConstMetric(c.packagesDesc, prometheus.GaugeValue, float64(u.packages), labels...)
+	}
+}
+
+// scanStorage - add up the .apk files in every repo, packages in the blob store count in every
+// repo they're in
+func scanStorage(basedir string) (map[RepoLocation]repoUsage, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+	locs, err := FindRepoLocations(basedir, RepoLocation{})
+	if err != nil {
+		return nil, err
+	}
+	usage := map[RepoLocation]repoUsage{}
+	for _, loc := range locs {
+		files, err := listRepoPackageFiles(ctx, basedir, loc)
+		if err != nil {
+			return nil, err
+		}
+		var u repoUsage
+		for _, f := range files {
+			u.bytes += f.Size
+			u.packages++
+		}
+		usage[loc] = u
+	}
+	return usage, nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanStorage(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-metrics-*")
+	if err != nil {
+		t.Fatal("failed to create testScanStorage tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testScanStorage tmpDir", err)
+		}
+	}()
+
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	dir := filepath.Join(tmpDir, "static", loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test repo dir", err)
+	}
+	for name, size := range map[string]int{"foo-1.0-r0.apk": 10, "bar-2.0-r0.apk": 32, "APKINDEX.tar.gz": 100} {
+		err = os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644)
+		if err != nil {
+			t.Fatal("failed to write test file", err)
+		}
+	}
+
+	usage, err := scanStorage("file://" + tmpDir)
+	assert.NoError(t, err)
+	assert.Equal(t, map[RepoLocation]repoUsage{loc: {bytes: 42, packages: 2}}, usage)
+}
+
+func TestIndexGenerationMetrics(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-metrics-*")
+	if err != nil {
+		t.Fatal("failed to create testIndexGenerationMetrics tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testIndexGenerationMetrics tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+	err = os.MkdirAll(filepath.Join(tmpDir, "static", "metricsorg", "alpine", "edge", "main", "x86_64"), 0755)
+	if err != nil {
+		t.Fatal("failed to create test repo dir", err)
+	}
+	labels := []string{"metricsorg", "alpine", "edge", "main", "x86_64"}
+
+	// there's no key to sign with yet
+	err = GenerateAPKIndex(basedir, "metricsorg", "alpine", "edge", "main", "x86_64")
+	assert.Error(t, err)
+	assert.Equal(t, 1.0, testutil.ToFloat64(indexFailuresTotal.WithLabelValues(labels...)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(indexLastSuccess.WithLabelValues(labels...)))
+
+	_, err = createDistroKey(basedir, "metricsorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	err = GenerateAPKIndex(basedir, "metricsorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, testutil.ToFloat64(indexFailuresTotal.WithLabelValues(labels...)))
+	assert.NotZero(t, testutil.ToFloat64(indexLastSuccess.WithLabelValues(labels...)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(indexQueueDepth))
+}
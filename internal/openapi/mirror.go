@@ -0,0 +1,830 @@
+package api
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+	"gopkg.in/yaml.v3"
+)
+
+// a repo can mirror an upstream apk repository instead of taking uploads, it's set up with
+// config/<org>/<distro>/<version>/<repo>/mirror.yaml:
+//
+//	upstream: https://dl-cdn.alpinelinux.org/alpine/v3.20/main
+//	arches: [x86_64, aarch64]
+//	interval: 6h
+//
+// and the upstream's public keys in mirror-keys/ next to it, named the way upstream signs with
+// them (e.g. alpine-devel@lists.alpinelinux.org-6165ee59.rsa.pub)
+// a sync checks the upstream APKINDEX against those keys, downloads the packages that are new or
+// changed, then writes the index as it came and removes the packages that aren't in it any more,
+// so clients keep verifying everything against the upstream keys rather than ours
+
+const (
+	mirrorConfigFile = "mirror.yaml"
+	mirrorStatusFile = "mirror-status.yaml"
+	mirrorKeysDir    = "mirror-keys"
+
+	defaultMirrorInterval = time.Hour
+	// mirrorCheckInterval - how often the scheduler looks for mirrors that are due a sync
+	mirrorCheckInterval = time.Minute
+
+	// maxMirrorIndexSize - upstream indexes bigger than this are refused, main is about 2MB
+	maxMirrorIndexSize = 64 << 20
+	// maxMirrorPackageSize - the limit for packages whose index entry doesn't have a size
+	maxMirrorPackageSize = 4 << 30
+)
+
+var (
+	errMirrorRepo    = errors.New("repo is a mirror of an upstream repo")
+	errMirrorSyncing = errors.New("mirror is already syncing")
+)
+
+// mirrorHTTPClient - everything fetched from upstream goes through this
+var mirrorHTTPClient = &http.Client{Timeout: 30 * time.Minute}
+
+// mirrorConfig - the contents of mirror.yaml
+type mirrorConfig struct {
+	Upstream string        `yaml:"upstream"`
+	Arches   []string      `yaml:"arches"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+}
+
+func (m mirrorConfig) interval() time.Duration {
+	if m.Interval <= 0 {
+		return defaultMirrorInterval
+	}
+	return m.Interval
+}
+
+// archURL - where an arch's files are upstream
+func (m mirrorConfig) archURL(arch, name string) string {
+	return strings.TrimSuffix(m.Upstream, "/") + "/" + arch + "/" + name
+}
+
+// mirrorArchState - how the last sync of one arch went
+type mirrorArchState struct {
+	LastSync    time.Time `yaml:"lastSync,omitempty"`
+	LastSuccess time.Time `yaml:"lastSuccess,omitempty"`
+	Error       string    `yaml:"error,omitempty"`
+	SignedBy    string    `yaml:"signedBy,omitempty"`
+	Packages    int       `yaml:"packages"`
+	Downloaded  int       `yaml:"downloaded"`
+	Removed     int       `yaml:"removed"`
+}
+
+// mirrorState - the contents of mirror-status.yaml
+type mirrorState struct {
+	Arches map[string]mirrorArchState `yaml:"arches"`
+}
+
+// the mirrors that are syncing right now, keyed by their config dir
+var mirrorSyncs = struct {
+	sync.Mutex
+	running map[string]bool
+}{running: make(map[string]bool)}
+
+// mirrorConfigURI - the config dir for a mirrored repo, loc.Arch is ignored
+func mirrorConfigURI(basedir string, loc RepoLocation) string {
+	return url.JoinUNC(basedir, "config", loc.Org, loc.Distro, loc.Version, loc.Repo)
+}
+
+// loadMirrorConfig - read a repo's mirror.yaml, repos that aren't mirrors return nil
+func loadMirrorConfig(basedir string, loc RepoLocation) (*mirrorConfig, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	uri := url.JoinUNC(mirrorConfigURI(basedir, loc), mirrorConfigFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil || !ex {
+		return nil, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var cfg mirrorConfig
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if cfg.Upstream == "" {
+		return nil, fmt.Errorf("%s doesn't have an upstream", uri)
+	}
+	if len(cfg.Arches) == 0 {
+		return nil, fmt.Errorf("%s doesn't have any arches", uri)
+	}
+	return &cfg, nil
+}
+
+// readOnlyRepoError - errMirrorRepo, errProxyRepo or errVirtualRepo if a repo's packages come
+// from somewhere else, errors reading the config count too so a broken mirror.yaml (etc) doesn't
+// let uploads through
+func readOnlyRepoError(basedir string, loc RepoLocation) error {
+	mirror, err := loadMirrorConfig(basedir, loc)
+	if mirror != nil || err != nil {
+		return errMirrorRepo
+	}
+	proxy, err := loadProxyConfig(basedir, loc)
+	if proxy != nil || err != nil {
+		return errProxyRepo
+	}
+	virtual, err := loadVirtualConfig(basedir, loc)
+	if virtual != nil || err != nil {
+		return errVirtualRepo
+	}
+	return nil
+}
+
+// loadMirrorState - read how the last syncs went
+func loadMirrorState(basedir string, loc RepoLocation) (mirrorState, error) {
+	state := mirrorState{Arches: map[string]mirrorArchState{}}
+	ctx := context.Background()
+	cfs := afs.New()
+	uri := url.JoinUNC(mirrorConfigURI(basedir, loc), mirrorStatusFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil || !ex {
+		return state, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return state, err
+	}
+	err = yaml.Unmarshal(data, &state)
+	if err != nil {
+		return state, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if state.Arches == nil {
+		state.Arches = map[string]mirrorArchState{}
+	}
+	return state, nil
+}
+
+func saveMirrorState(basedir string, loc RepoLocation, state mirrorState) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	cfs := afs.New()
+	return cfs.Upload(ctx, url.JoinUNC(mirrorConfigURI(basedir, loc), mirrorStatusFile), 0644, bytes.NewReader(data))
+}
+
+// loadUpstreamKeys - the upstream public keys for a mirror or proxy, keyed by the name upstream
+// signs with
+func loadUpstreamKeys(basedir string, loc RepoLocation) (map[string]*rsa.PublicKey, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	dir := url.JoinUNC(mirrorConfigURI(basedir, loc), mirrorKeysDir)
+	keys := map[string]*rsa.PublicKey{}
+	ex, err := cfs.Exists(ctx, dir)
+	if err != nil || !ex {
+		return keys, err
+	}
+	list, err := cfs.List(ctx, url.Normalize(dir, file.Scheme))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range list {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".pub") {
+			continue
+		}
+		data, err := cfs.DownloadWithURL(ctx, url.JoinUNC(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		pub, err := parsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mirror key %s: %w", f.Name(), err)
+		}
+		keys[f.Name()] = pub
+	}
+	return keys, nil
+}
+
+// gzipMembers - split concatenated gzip streams (which is what signed indexes and packages are)
+// into the raw bytes of each stream
+func gzipMembers(data []byte) ([][]byte, error) {
+	var members [][]byte
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		start := len(data) - r.Len()
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		zr.Multistream(false)
+		_, err = io.Copy(io.Discard, zr)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, data[start:len(data)-r.Len()])
+	}
+	return members, nil
+}
+
+// signatureEntries - the .SIGN.* entries in a gzip stream, nil if it has anything else in it
+func signatureEntries(member []byte) (map[string][]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(member))
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(zr)
+	entries := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		// signature blocks don't have an end of archive marker, so they just run out
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(hdr.Name, ".SIGN.") {
+			return nil, nil
+		}
+		body, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		entries[hdr.Name] = body
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries, nil
+}
+
+// splitSignatures - split a signed archive into its signatures and the bytes they cover
+func splitSignatures(data []byte) (map[string][]byte, []byte, error) {
+	members, err := gzipMembers(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	sigs := map[string][]byte{}
+	offset := 0
+	for _, m := range members {
+		entries, err := signatureEntries(m)
+		if err != nil {
+			return nil, nil, err
+		}
+		if entries == nil {
+			break
+		}
+		for k, v := range entries {
+			sigs[k] = v
+		}
+		offset += len(m)
+	}
+	return sigs, data[offset:], nil
+}
+
+// verifyIndexSignature - check a signed APKINDEX against a set of public keys, returning the name
+// of the key that verified it
+func verifyIndexSignature(data []byte, keys map[string]*rsa.PublicKey) (string, error) {
+	sigs, signed, err := splitSignatures(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to read index: %w", err)
+	}
+	if len(sigs) == 0 {
+		return "", errors.New("index isn't signed")
+	}
+	var names []string
+	for entry, sig := range sigs {
+		var name string
+		var h crypto.Hash
+		switch {
+		case strings.HasPrefix(entry, ".SIGN.RSA256."):
+			name, h = strings.TrimPrefix(entry, ".SIGN.RSA256."), crypto.SHA256
+		case strings.HasPrefix(entry, ".SIGN.RSA."):
+			name, h = strings.TrimPrefix(entry, ".SIGN.RSA."), crypto.SHA1
+		default:
+			continue
+		}
+		names = append(names, name)
+		pub, ok := keys[name]
+		if !ok {
+			continue
+		}
+		hasher := h.New()
+		hasher.Write(signed)
+		if rsa.VerifyPKCS1v15(pub, h, hasher.Sum(nil), sig) == nil {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("index isn't signed by a trusted key (signed by %s)", strings.Join(names, ", "))
+}
+
+// hashingReader - feeds a gzip reader a byte at a time, so it doesn't read past the end of a
+// member, and hashes everything it hands over
+type hashingReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (r *hashingReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.h.Write([]byte{b})
+	}
+	return b, err
+}
+
+func (r *hashingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.h.Write(p[:n])
+	return n, err
+}
+
+// packageChecksumMatches - whether a package read from r matches the checksum in its index entry,
+// which covers the control stream (the first one after the signatures), sha1 or sha256 depending
+// on its length. r is only read up to the end of the control stream
+func packageChecksumMatches(r io.Reader, checksum []byte) bool {
+	var newHash func() hash.Hash
+	switch len(checksum) {
+	case sha1.Size:
+		newHash = sha1.New
+	case sha256.Size:
+		newHash = sha256.New
+	default:
+		return false
+	}
+	hr := &hashingReader{r: bufio.NewReader(r)}
+	var zr *gzip.Reader
+	for {
+		hr.h = newHash()
+		var err error
+		if zr == nil {
+			zr, err = gzip.NewReader(hr)
+		} else {
+			err = zr.Reset(hr)
+		}
+		if err != nil {
+			return false
+		}
+		zr.Multistream(false)
+		hdr, err := tar.NewReader(zr).Next()
+		signatures := err == nil && strings.HasPrefix(hdr.Name, ".SIGN.")
+		_, err = io.Copy(io.Discard, zr)
+		if err != nil {
+			return false
+		}
+		if !signatures {
+			return bytes.Equal(hr.h.Sum(nil), checksum)
+		}
+	}
+}
+
+// openUpstream - start downloading a file from upstream
+func openUpstream(ctx context.Context, uri string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mirrorHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", uri, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// fetchUpstream - download a file from upstream, anything bigger than limit is an error
+func fetchUpstream(ctx context.Context, uri string, limit int64) ([]byte, error) {
+	body, err := openUpstream(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", uri, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s is bigger than %d bytes", uri, limit)
+	}
+	return data, nil
+}
+
+// stageUpstreamPackage - download a package from upstream straight into a staging file, checking
+// it against the checksum from the upstream index on the way, so it's never held in memory
+// errors writing the staging file are errStaging, anything else is upstream's fault
+func stageUpstreamPackage(ctx context.Context, basedir, uri, name string, limit int64, checksum []byte) (stagedFile, error) {
+	body, err := openUpstream(ctx, uri)
+	if err != nil {
+		return stagedFile{}, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	cfs := afs.New()
+	matches := false
+	staged, err := stageFile(ctx, cfs, basedir, io.LimitReader(body, limit+1), func(r io.Reader) error {
+		matches = packageChecksumMatches(r, checksum)
+		return nil
+	})
+	if err != nil {
+		var staging errStaging
+		if errors.As(err, &staging) {
+			return staged, err
+		}
+		return staged, fmt.Errorf("%s: %w", uri, err)
+	}
+	switch {
+	case staged.size > limit:
+		err = fmt.Errorf("%s is bigger than %d bytes", uri, limit)
+	case !matches:
+		err = fmt.Errorf("%s doesn't match the checksum in the upstream index", name)
+	}
+	if err != nil {
+		_ = cfs.Delete(context.WithoutCancel(ctx), staged.uri)
+		return staged, err
+	}
+	return staged, nil
+}
+
+// repoFileChecksumMatches - whether a file that's already in a repo matches an index checksum
+func repoFileChecksumMatches(ctx context.Context, cfs afs.Service, fileURI string, checksum []byte) bool {
+	r, err := cfs.OpenURL(ctx, fileURI)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	return packageChecksumMatches(r, checksum)
+}
+
+// syncMirrorArch - bring one arch of a mirror up to date with upstream
+func syncMirrorArch(ctx context.Context, basedir string, loc RepoLocation, cfg mirrorConfig, keys map[string]*rsa.PublicKey) (mirrorArchState, error) {
+	var st mirrorArchState
+	index, err := fetchUpstream(ctx, cfg.archURL(loc.Arch, "APKINDEX.tar.gz"), maxMirrorIndexSize)
+	if err != nil {
+		return st, err
+	}
+	st.SignedBy, err = verifyIndexSignature(index, keys)
+	if err != nil {
+		return st, err
+	}
+	apki, err := repository.IndexFromArchive(io.NopCloser(bytes.NewReader(index)))
+	if err != nil {
+		return st, fmt.Errorf("failed to parse upstream index: %w", err)
+	}
+	st.Packages = len(apki.Packages)
+
+	cfs := afs.New()
+	staticURI := loc.staticURI(basedir)
+	// where each package we already have is stored
+	onDisk := map[string]string{}
+	files, err := listRepoPackageFiles(ctx, basedir, loc)
+	if err != nil {
+		return st, err
+	}
+	for _, f := range files {
+		onDisk[f.Name] = f.URI
+	}
+	// what we got last time, so unchanged packages don't have to be read back in
+	have := map[string][]byte{}
+	previous, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		log.Warn().Err(err).Str("uri", staticURI).Msg("failed to read previous mirror index, checking packages on disk")
+	}
+	for _, p := range previous {
+		if onDisk[p.Filename()] != "" {
+			have[p.Filename()] = p.Checksum
+		}
+	}
+
+	wanted := map[string]bool{}
+	for _, p := range apki.Packages {
+		name := p.Filename()
+		// the name goes straight into a path, so don't let upstream walk out of the repo
+		if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+			return st, fmt.Errorf("upstream index has a bad package name %q", name)
+		}
+		wanted[name] = true
+		if len(p.Checksum) > 0 && bytes.Equal(have[name], p.Checksum) {
+			continue
+		}
+		if fileURI := onDisk[name]; fileURI != "" {
+			// left behind by a sync that failed part way through
+			if repoFileChecksumMatches(ctx, cfs, fileURI, p.Checksum) {
+				continue
+			}
+		}
+		limit := int64(maxMirrorPackageSize)
+		if p.Size > 0 {
+			limit = int64(p.Size)
+		}
+		staged, err := stageUpstreamPackage(ctx, basedir, cfg.archURL(loc.Arch, name), name, limit, p.Checksum)
+		if err != nil {
+			return st, err
+		}
+		err = commitRepoFile(ctx, basedir, loc, name, staged)
+		if err != nil {
+			return st, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		st.Downloaded++
+	}
+
+	// the index goes in once every package it lists is there
+	indexURI := url.JoinUNC(staticURI, "APKINDEX.tar.gz")
+	_ = cfs.Delete(ctx, indexURI)
+	err = cfs.Upload(ctx, indexURI, 0644, bytes.NewReader(index))
+	if err != nil {
+		return st, fmt.Errorf("failed to write index: %w", err)
+	}
+
+	for name := range onDisk {
+		if wanted[name] {
+			continue
+		}
+		err := removeRepoFile(ctx, basedir, loc, name)
+		if err != nil {
+			log.Error().Err(err).Str("uri", staticURI).Str("package", name).Msg("failed to remove package that's gone from upstream")
+			continue
+		}
+		st.Removed++
+	}
+	return st, nil
+}
+
+// syncMirror - sync every arch of a mirror, errors for each arch are recorded in its status
+// and joined together in the result
+func syncMirror(ctx context.Context, basedir string, loc RepoLocation) error {
+	key := mirrorConfigURI(basedir, loc)
+	mirrorSyncs.Lock()
+	if mirrorSyncs.running[key] {
+		mirrorSyncs.Unlock()
+		return errMirrorSyncing
+	}
+	mirrorSyncs.running[key] = true
+	mirrorSyncs.Unlock()
+	defer func() {
+		mirrorSyncs.Lock()
+		delete(mirrorSyncs.running, key)
+		mirrorSyncs.Unlock()
+	}()
+
+	cfg, err := loadMirrorConfig(basedir, loc)
+	if err != nil {
+		return err
+	}
+	if cfg == nil {
+		return fmt.Errorf("%s/%s/%s/%s isn't a mirror", loc.Org, loc.Distro, loc.Version, loc.Repo)
+	}
+	keys, err := loadUpstreamKeys(basedir, loc)
+	if err != nil {
+		return fmt.Errorf("failed to load mirror keys: %w", err)
+	}
+	state, err := loadMirrorState(basedir, loc)
+	if err != nil {
+		log.Warn().Err(err).Str("uri", key).Msg("failed to read mirror status, starting again")
+	}
+
+	var errs []error
+	for _, arch := range cfg.Arches {
+		archLoc := loc
+		archLoc.Arch = arch
+		logger := log.With().Str("org", loc.Org).Str("distro", loc.Distro).Str("version", loc.Version).Str("repo", loc.Repo).Str("arch", arch).Logger()
+		logger.Info().Str("upstream", cfg.Upstream).Msg("syncing mirror")
+
+		st, err := syncMirrorArch(ctx, basedir, archLoc, *cfg, keys)
+		prev := state.Arches[arch]
+		st.LastSync = time.Now().UTC()
+		if err != nil {
+			logger.Error().Err(err).Msg("mirror sync failed")
+			errs = append(errs, fmt.Errorf("%s: %w", arch, err))
+			// keep what the last good sync found, it's still what's being served
+			prev.LastSync, prev.Error = st.LastSync, err.Error()
+			state.Arches[arch] = prev
+			continue
+		}
+		st.LastSuccess = st.LastSync
+		state.Arches[arch] = st
+		regenerateVirtualRepos(basedir, archLoc, nil)
+		logger.Info().Int("packages", st.Packages).Int("downloaded", st.Downloaded).Int("removed", st.Removed).Msg("mirror synced")
+	}
+	err = saveMirrorState(basedir, loc, state)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to save mirror status: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// findMirrors - every repo with a mirror.yaml
+func findMirrors(basedir string) ([]RepoLocation, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+	configURI := url.JoinUNC(basedir, "config")
+	ex, err := cfs.Exists(ctx, configURI)
+	if err != nil || !ex {
+		return nil, err
+	}
+	// config/<org>/<distro>/<version>/<repo>
+	var subdirs func(uri string) ([]string, error)
+	subdirs = func(uri string) ([]string, error) {
+		list, err := cfs.List(ctx, url.Normalize(uri, file.Scheme))
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, f := range list {
+			if f.IsDir() && f.URL() != url.Normalize(uri, file.Scheme) && f.Name() != mirrorKeysDir {
+				names = append(names, f.Name())
+			}
+		}
+		return names, nil
+	}
+	var mirrors []RepoLocation
+	orgs, err := subdirs(configURI)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		distros, err := subdirs(url.JoinUNC(configURI, org))
+		if err != nil {
+			return nil, err
+		}
+		for _, distro := range distros {
+			versions, err := subdirs(url.JoinUNC(configURI, org, distro))
+			if err != nil {
+				return nil, err
+			}
+			for _, version := range versions {
+				repos, err := subdirs(url.JoinUNC(configURI, org, distro, version))
+				if err != nil {
+					return nil, err
+				}
+				for _, repo := range repos {
+					ex, err := cfs.Exists(ctx, url.JoinUNC(configURI, org, distro, version, repo, mirrorConfigFile))
+					if err != nil {
+						return nil, err
+					}
+					if ex {
+						mirrors = append(mirrors, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo})
+					}
+				}
+			}
+		}
+	}
+	return mirrors, nil
+}
+
+// mirrorDue - whether a mirror's oldest arch was last synced more than an interval ago
+func mirrorDue(cfg mirrorConfig, state mirrorState, now time.Time) bool {
+	for _, arch := range cfg.Arches {
+		st, ok := state.Arches[arch]
+		if !ok || now.Sub(st.LastSync) >= cfg.interval() {
+			return true
+		}
+	}
+	return false
+}
+
+// RunMirrorSync - sync mirrors whenever they're due, until ctx is cancelled
+// mirrors sync one at a time so a big first sync doesn't hammer upstream
+func RunMirrorSync(ctx context.Context, basedir string) {
+	ticker := time.NewTicker(mirrorCheckInterval)
+	defer ticker.Stop()
+	for {
+		mirrors, err := findMirrors(basedir)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to look for mirrors")
+		}
+		for _, loc := range mirrors {
+			if ctx.Err() != nil {
+				return
+			}
+			cfg, err := loadMirrorConfig(basedir, loc)
+			if err != nil || cfg == nil {
+				log.Error().Err(err).Str("uri", mirrorConfigURI(basedir, loc)).Msg("failed to load mirror config")
+				continue
+			}
+			state, _ := loadMirrorState(basedir, loc)
+			if !mirrorDue(*cfg, state, time.Now()) {
+				continue
+			}
+			// failures are logged and recorded in the status by syncMirror
+			_ = syncMirror(ctx, basedir, loc)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// mirrorStatus - the API's view of a mirror
+func mirrorStatus(basedir string, loc RepoLocation, cfg mirrorConfig) MirrorStatus {
+	state, err := loadMirrorState(basedir, loc)
+	if err != nil {
+		log.Error().Err(err).Str("uri", mirrorConfigURI(basedir, loc)).Msg("failed to read mirror status")
+	}
+	mirrorSyncs.Lock()
+	syncing := mirrorSyncs.running[mirrorConfigURI(basedir, loc)]
+	mirrorSyncs.Unlock()
+	interval := cfg.interval().String()
+	status := MirrorStatus{Upstream: cfg.Upstream, Interval: &interval, Syncing: syncing, Arches: []MirrorArchStatus{}}
+	for _, arch := range cfg.Arches {
+		as := MirrorArchStatus{Arch: arch}
+		if st, ok := state.Arches[arch]; ok {
+			if !st.LastSync.IsZero() {
+				as.LastSync = &st.LastSync
+			}
+			if !st.LastSuccess.IsZero() {
+				as.LastSuccess = &st.LastSuccess
+				as.SignedBy = &st.SignedBy
+				as.Packages, as.Downloaded, as.Removed = &st.Packages, &st.Downloaded, &st.Removed
+			}
+			if st.Error != "" {
+				as.Error = &st.Error
+			}
+		}
+		status.Arches = append(status.Arches, as)
+	}
+	return status
+}
+
+// loadMirrorForRequest - load a mirror's config, sending the error response if there's a problem
+func loadMirrorForRequest(ctx echo.Context, loc RepoLocation) (*mirrorConfig, error) {
+	cfg, err := loadMirrorConfig(PackageBaseDirectory, loc)
+	if err != nil {
+		log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Msg("failed to load mirror config")
+		return nil, ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to load mirror config"})
+	}
+	if cfg == nil {
+		return nil, ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "repo isn't a mirror"})
+	}
+	return cfg, nil
+}
+
+// GetMirrorStatus - how the last syncs of a mirrored repo went
+func (p *PkgRepoAPI) GetMirrorStatus(ctx echo.Context, org, distro, version, repo string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	cfg, err := loadMirrorForRequest(ctx, loc)
+	if cfg == nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, mirrorStatus(PackageBaseDirectory, loc, *cfg))
+}
+
+// SyncMirror - sync a mirrored repo now rather than waiting for the scheduler
+func (p *PkgRepoAPI) SyncMirror(ctx echo.Context, org, distro, version, repo string, params SyncMirrorParams) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	cfg, err := loadMirrorForRequest(ctx, loc)
+	if cfg == nil {
+		return err
+	}
+	mirrorSyncs.Lock()
+	running := mirrorSyncs.running[mirrorConfigURI(PackageBaseDirectory, loc)]
+	mirrorSyncs.Unlock()
+	if running {
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: errMirrorSyncing.Error()})
+	}
+
+	if params.Wait != nil && *params.Wait {
+		err := syncMirror(ctx.Request().Context(), PackageBaseDirectory, loc)
+		if errors.Is(err, errMirrorSyncing) {
+			return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+		}
+		// per arch failures are in the status
+		return ctx.JSON(http.StatusOK, mirrorStatus(PackageBaseDirectory, loc, *cfg))
+	}
+	go func() {
+		_ = syncMirror(context.Background(), PackageBaseDirectory, loc)
+	}()
+	status := mirrorStatus(PackageBaseDirectory, loc, *cfg)
+	status.Syncing = true
+	return ctx.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+// testUpstream - a stand in for an upstream apk repo, serving whatever is in files
+type testUpstream struct {
+	t     *testing.T
+	key   *rsa.PrivateKey
+	files map[string][]byte
+}
+
+func (u *testUpstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, ok := u.files[strings.TrimPrefix(r.URL.Path, "/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// publish - put packages and a signed index for them under arch/
+func (u *testUpstream) publish(arch string, apks map[string][]byte) {
+	u.t.Helper()
+	for name := range u.files {
+		if strings.HasPrefix(name, arch+"/") {
+			delete(u.files, name)
+		}
+	}
+	var pkgs []*repository.Package
+	for nameVersion, apk := range apks {
+		members, err := gzipMembers(apk)
+		if err != nil {
+			u.t.Fatal("failed to split test package", err)
+		}
+		// the checksum covers the control stream, which comes after the signature
+		sum := sha1.Sum(members[1])
+		name, version, _ := strings.Cut(nameVersion, " ")
+		p := &repository.Package{Name: name, Version: version, Arch: arch, Checksum: sum[:], Size: uint64(len(apk))}
+		pkgs = append(pkgs, p)
+		u.files[arch+"/"+p.Filename()] = apk
+	}
+	archive, err := repository.ArchiveFromIndex(&repository.ApkIndex{Description: "upstream", Packages: pkgs})
+	if err != nil {
+		u.t.Fatal("failed to create test index", err)
+	}
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		u.t.Fatal("failed to read test index", err)
+	}
+	signed, err := signArchive(data, []Signer{NewKeySigner(u.key, "upstream@example.com-1.rsa.pub")})
+	if err != nil {
+		u.t.Fatal("failed to sign test index", err)
+	}
+	u.files[arch+"/APKINDEX.tar.gz"] = signed
+}
+
+// writeMirrorConfig - set up a repo to mirror upstream, trusting key
+func writeMirrorConfig(t *testing.T, tmpDir string, loc RepoLocation, upstream string, key *rsa.PublicKey) {
+	t.Helper()
+	dir := filepath.Join(tmpDir, "config", loc.Org, loc.Distro, loc.Version, loc.Repo)
+	err := os.MkdirAll(filepath.Join(dir, mirrorKeysDir), 0755)
+	if err != nil {
+		t.Fatal("failed to create test mirror config dir", err)
+	}
+	err = os.WriteFile(filepath.Join(dir, mirrorConfigFile), []byte("upstream: "+upstream+"\narches: [x86_64]\ninterval: 6h\n"), 0644)
+	if err != nil {
+		t.Fatal("failed to write test mirror config", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal("failed to encode test key", err)
+	}
+	pub := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	err = os.WriteFile(filepath.Join(dir, mirrorKeysDir, "upstream@example.com-1.rsa.pub"), pub, 0644)
+	if err != nil {
+		t.Fatal("failed to write test mirror key", err)
+	}
+}
+
+func TestSyncMirror(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-mirror-*")
+	if err != nil {
+		t.Fatal("failed to create testSyncMirror tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testSyncMirror tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+
+	key, err := rsa.GenerateKey(rand.Reader, minKeyBits)
+	if err != nil {
+		t.Fatal("failed to generate test key", err)
+	}
+	upstream := &testUpstream{t: t, key: key, files: map[string][]byte{}}
+	srv := httptest.NewServer(upstream)
+	defer srv.Close()
+
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "v3.20", Repo: "main"}
+	writeMirrorConfig(t, tmpDir, loc, srv.URL+"/", &key.PublicKey)
+	staticDir := filepath.Join(tmpDir, "static", "testorg", "alpine", "v3.20", "main", "x86_64")
+
+	foo := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	bar := buildTestApk(t, "bar", "2.0-r0", "x86_64")
+	upstream.publish("x86_64", map[string][]byte{"foo 1.0-r0": foo, "bar 2.0-r0": bar})
+
+	mirrors, err := findMirrors(basedir)
+	assert.NoError(t, err)
+	assert.Equal(t, []RepoLocation{loc}, mirrors)
+
+	err = syncMirror(context.Background(), basedir, loc)
+	assert.NoError(t, err)
+	// served exactly as they came from upstream
+	data, err := os.ReadFile(filepath.Join(staticDir, "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	data, err = os.ReadFile(filepath.Join(staticDir, "APKINDEX.tar.gz"))
+	assert.NoError(t, err)
+	assert.Equal(t, upstream.files["x86_64/APKINDEX.tar.gz"], data)
+
+	state, err := loadMirrorState(basedir, loc)
+	assert.NoError(t, err)
+	st := state.Arches["x86_64"]
+	assert.Equal(t, 2, st.Packages)
+	assert.Equal(t, 2, st.Downloaded)
+	assert.Equal(t, "upstream@example.com-1.rsa.pub", st.SignedBy)
+	assert.Empty(t, st.Error)
+	assert.False(t, mirrorDue(mirrorConfig{Arches: []string{"x86_64"}, Interval: defaultMirrorInterval}, state, st.LastSync))
+
+	// uploads and our own indexes aren't allowed
+	assert.True(t, errors.Is(readOnlyRepoError(basedir, loc), errMirrorRepo))
+	err = GenerateAPKIndex(basedir, loc.Org, loc.Distro, loc.Version, loc.Repo, "x86_64")
+	assert.True(t, errors.Is(err, errMirrorRepo))
+
+	// a new foo and bar going away
+	foo2 := buildTestApk(t, "foo", "1.1-r0", "x86_64")
+	upstream.publish("x86_64", map[string][]byte{"foo 1.1-r0": foo2})
+	err = syncMirror(context.Background(), basedir, loc)
+	assert.NoError(t, err)
+	state, err = loadMirrorState(basedir, loc)
+	assert.NoError(t, err)
+	st = state.Arches["x86_64"]
+	assert.Equal(t, 1, st.Packages)
+	assert.Equal(t, 1, st.Downloaded)
+	assert.Equal(t, 2, st.Removed)
+	assert.FileExists(t, filepath.Join(staticDir, "foo-1.1-r0.apk"))
+	assert.NoFileExists(t, filepath.Join(staticDir, "foo-1.0-r0.apk"))
+	assert.NoFileExists(t, filepath.Join(staticDir, "bar-2.0-r0.apk"))
+
+	// a package that doesn't match the index fails the sync and leaves the old index in place
+	goodIndex := upstream.files["x86_64/APKINDEX.tar.gz"]
+	baz := buildTestApk(t, "baz", "1.0-r0", "x86_64")
+	upstream.publish("x86_64", map[string][]byte{"foo 1.1-r0": foo2, "baz 1.0-r0": baz})
+	members, err := gzipMembers(baz)
+	assert.NoError(t, err)
+	corrupt := append([]byte{}, baz...)
+	corrupt[len(members[0])+len(members[1])/2] ^= 0xff
+	upstream.files["x86_64/baz-1.0-r0.apk"] = corrupt
+	err = syncMirror(context.Background(), basedir, loc)
+	assert.ErrorContains(t, err, "checksum")
+	data, err = os.ReadFile(filepath.Join(staticDir, "APKINDEX.tar.gz"))
+	assert.NoError(t, err)
+	assert.Equal(t, goodIndex, data)
+	state, err = loadMirrorState(basedir, loc)
+	assert.NoError(t, err)
+	assert.Contains(t, state.Arches["x86_64"].Error, "checksum")
+	assert.Equal(t, 1, state.Arches["x86_64"].Packages)
+	// and doesn't leave the download behind
+	assert.NoFileExists(t, filepath.Join(staticDir, "baz-1.0-r0.apk"))
+	staging, err := os.ReadDir(filepath.Join(tmpDir, uploadsDir))
+	assert.NoError(t, err)
+	assert.Empty(t, staging)
+
+	// so does an index signed by a key we don't trust
+	other, err := rsa.GenerateKey(rand.Reader, minKeyBits)
+	if err != nil {
+		t.Fatal("failed to generate test key", err)
+	}
+	upstream.key = other
+	upstream.publish("x86_64", map[string][]byte{"foo 1.1-r0": foo2})
+	err = syncMirror(context.Background(), basedir, loc)
+	assert.ErrorContains(t, err, "trusted key")
+	data, err = os.ReadFile(filepath.Join(staticDir, "APKINDEX.tar.gz"))
+	assert.NoError(t, err)
+	assert.Equal(t, goodIndex, data)
+}
+
+func TestPackageChecksumMatches(t *testing.T) {
+	apk := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	members, err := gzipMembers(apk)
+	assert.NoError(t, err)
+	// the control stream is the one after the signature
+	sum1 := sha1.Sum(members[1])
+	sum256 := sha256.Sum256(members[1])
+	assert.True(t, packageChecksumMatches(bytes.NewReader(apk), sum1[:]))
+	assert.True(t, packageChecksumMatches(bytes.NewReader(apk), sum256[:]))
+	data := sha1.Sum(members[2])
+	assert.False(t, packageChecksumMatches(bytes.NewReader(apk), data[:]))
+	assert.False(t, packageChecksumMatches(bytes.NewReader(apk), sum1[:10]))
+	// cut off part way through the control stream
+	assert.False(t, packageChecksumMatches(bytes.NewReader(apk[:len(members[0])+len(members[1])/2]), sum1[:]))
+	// it doesn't need the signature either
+	assert.True(t, packageChecksumMatches(bytes.NewReader(apk[len(members[0]):]), sum1[:]))
+}
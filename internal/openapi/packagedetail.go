@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+var errPackageNotFound = errors.New("package not found")
+
+// findIndexedPackage - look a package up in a repo's index, an empty version picks the latest
+func findIndexedPackage(basedir string, loc RepoLocation, name, version string) (*repository.Package, error) {
+	pkgs, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		return nil, err
+	}
+	var found *repository.Package
+	for _, pkg := range pkgs {
+		if pkg.Name != name {
+			continue
+		}
+		if version != "" {
+			if pkg.Version == version {
+				return pkg, nil
+			}
+			continue
+		}
+		if found == nil || compareVersions(pkg.Version, found.Version) > 0 {
+			found = pkg
+		}
+	}
+	if found == nil {
+		return nil, errPackageNotFound
+	}
+	return found, nil
+}
+
+// getPackageDetail - combine the index entry for a package with what we find inside the .apk
+func getPackageDetail(basedir string, loc RepoLocation, name, version string) (*PackageDetail, error) {
+	pkg, err := findIndexedPackage(basedir, loc, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	cfs := afs.New()
+	err = cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+	apkURI, err := resolveRepoFile(ctx, basedir, loc, pkg.Filename())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", pkg.Filename(), err)
+	}
+	rdr, err := cfs.OpenURL(ctx, apkURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", pkg.Filename(), err)
+	}
+	defer func() {
+		err := rdr.Close()
+		if err != nil {
+			log.Debug().Err(err).Str("uri", apkURI).Msg("getPackageDetail: failed to close package")
+		}
+	}()
+
+	contents, err := inspectApk(rdr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", pkg.Filename(), err)
+	}
+
+	detail := &PackageDetail{
+		Package: indexedPackageFrom(loc, pkg),
+		Pkginfo: contents.pkginfo,
+		Files:   make([]PackageFile, 0, len(contents.files)),
+		Scripts: []string{},
+	}
+	detail.Scripts = append(detail.Scripts, contents.scripts...)
+	if contents.signatureKey != "" {
+		detail.SignatureKey = &contents.signatureKey
+	}
+	for _, f := range contents.files {
+		pf := PackageFile{
+			Name: f.name,
+			Size: f.size,
+			Mode: fmt.Sprintf("%04o", f.mode&07777),
+			Type: f.typ,
+		}
+		if f.linkTarget != "" {
+			pf.LinkTarget = &f.linkTarget
+		}
+		detail.Files = append(detail.Files, pf)
+	}
+
+	return detail, nil
+}
+
+func sendPackageDetail(ctx echo.Context, loc RepoLocation, name, version string) error {
+	detail, err := getPackageDetail(PackageBaseDirectory, loc, name, version)
+	if errors.Is(err, errPackageNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "package not found"})
+	}
+	if errors.Is(err, errDamagedPackage) {
+		log.Warn().Err(err).Str("name", name).Str("version", version).Interface("location", loc).Msg("package in the index can't be read")
+		return ctx.JSON(http.StatusUnprocessableEntity, Error{Code: http.StatusUnprocessableEntity, Message: err.Error()})
+	}
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Str("version", version).Interface("location", loc).Msg("failed to get package detail")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to get package detail"})
+	}
+	return ctx.JSON(http.StatusOK, detail)
+}
+
+// GetPackage - details about the latest version of a package in a repo
+func (p *PkgRepoAPI) GetPackage(ctx echo.Context, org, distro, version, repo, arch, name string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	return sendPackageDetail(ctx, loc, name, "")
+}
+
+// GetPackageVersion - details about a specific version of a package in a repo
+func (p *PkgRepoAPI) GetPackageVersion(ctx echo.Context, org, distro, version, repo, arch, name, pkgVersion string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	return sendPackageDetail(ctx, loc, name, pkgVersion)
+}
@@ -2,13 +2,14 @@
 package api
 
 import (
-	// "errors"
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
-	"gitlab.alpinelinux.org/alpine/go/repository"
+	"github.com/viant/afs"
 )
 
 // TODO
@@ -67,7 +68,7 @@ func sendRepoError(ctx echo.Context, code int, message string) {
 }
 
 // ListRepos - list repos in an org
-func (p *PkgRepoAPI) ListRepos(ctx echo.Context, org, distro, version string) error {
+func (p *PkgRepoAPI) ListRepos(ctx echo.Context, org, distro, version string, params ListReposParams) error {
 	// log.Debug().Str("org", org).Msg("ListRepos request")
 	result, err := listRepos(org, distro, version)
 	if err != nil {
@@ -77,7 +78,7 @@ func (p *PkgRepoAPI) ListRepos(ctx echo.Context, org, distro, version string) er
 		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "org does not have any repos"})
 	}
 
-	return ctx.JSON(http.StatusOK, result)
+	return sendPage(ctx, result, func(r Repo) string { return r.Name }, params.Limit, params.Cursor, params.IfNoneMatch)
 }
 
 // CreateRepo - Add a new package repo
@@ -123,76 +124,146 @@ func (p *PkgRepoAPI) HeadHealthReady(ctx echo.Context) error {
 }
 
 // ListPackagesByRepo - list packages in an org's repo
-func (p *PkgRepoAPI) ListPackagesByRepo(ctx echo.Context, org, distro, version, repo, arch string) error {
+func (p *PkgRepoAPI) ListPackagesByRepo(ctx echo.Context, org, distro, version, repo, arch string, params ListPackagesByRepoParams) error {
 	pkgs, err := listPackages(org, distro, version, repo, arch)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: "failed to get package list"})
 	}
 
-	return ctx.JSON(http.StatusOK, pkgs)
+	return sendPage(ctx, pkgs, func(p Package) string { return p.Name }, params.Limit, params.Cursor, params.IfNoneMatch)
+}
+
+// uploadError - why an upload was rejected, the reason is the metrics label
+type uploadError struct {
+	code    int
+	reason  string
+	message string
+}
+
+// rejectUpload - count a rejected upload and tell the client why
+func rejectUpload(ctx echo.Context, org string, err uploadError) error {
+	observeUploadRejection(org, err.reason)
+	return ctx.JSON(err.code, Error{Code: int32(err.code), Message: err.message})
 }
 
 // CreatePackage - Create a package in a repo and regenerate the index
 func (p *PkgRepoAPI) CreatePackage(ctx echo.Context, org, distro, ver, repo, arch string) error {
 	log.Trace().Msg("CreatePackage")
-	file, err := ctx.FormFile("package")
-	if err != nil {
-		log.Warn().Err(err).Msg("failed to get file from submitted data")
+	if !validPathSegments(org, distro, ver, repo, arch) {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "bad_name", "invalid repo"})
 	}
-	src, err := file.Open()
+	err := readOnlyRepoError(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: ver, Repo: repo})
 	if err != nil {
-		log.Warn().Err(err).Msg("failed to open src file")
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "read_only_repo", err.Error()})
 	}
-	defer func() {
-		err := src.Close()
-		if err != nil {
-			log.Error().Err(err).Msg("failed to close src file")
-		}
-	}()
+	up, uerr := receiveMultipartUpload(ctx)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	log.Trace().Msg("storing uploaded file")
+	uerr = storeUpload(ctx.Request().Context(), RepoLocation{Org: org, Distro: distro, Version: ver, Repo: repo, Arch: arch}, up)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	auditUpload(ctx, arch, up)
 
-	pkg, err := repository.ParsePackage(src)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to parse package from uploaded file")
-		return ctx.JSON(http.StatusInternalServerError, errors.New("failed to parse upload"))
+	// go generateAPKIndex(org, distro, ver, repo, arch)
+
+	return ctx.JSON(http.StatusOK, &Package{Name: up.pkg.Name, Version: &up.pkg.Version})
+}
+
+// CreateNoarchPackage - add a noarch package to every arch in a repo and regenerate their indexes
+func (p *PkgRepoAPI) CreateNoarchPackage(ctx echo.Context, org, distro, ver, repo string) error {
+	if !validPathSegments(org, distro, ver, repo) {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "bad_name", "invalid repo"})
 	}
-	err = src.Close()
+	loc := RepoLocation{Org: org, Distro: distro, Version: ver, Repo: repo}
+	err := readOnlyRepoError(PackageBaseDirectory, loc)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to close src file")
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "read_only_repo", err.Error()})
+	}
+	arches, err := listArches(org, distro, ver, repo)
+	if err != nil || len(arches) == 0 {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "no_arches", "the repo doesn't have any arches yet, upload to one of them first"})
+	}
+	sort.Strings(arches)
+	up, uerr := receiveMultipartUpload(ctx)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	if up.pkg.Arch != "noarch" && up.pkg.Arch != "" {
+		_ = afs.New().Delete(ctx.Request().Context(), up.staged.uri)
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "not_noarch", fmt.Sprintf("%s is for %s, not noarch", up.name, up.pkg.Arch)})
 	}
 
-	log.Trace().Msg("writing uploaded file")
-	writeUploadedPkg(file, org, distro, ver, repo, arch)
-
-	// go generateAPKIndex(org, distro, ver, repo, arch)
+	// it's only uploaded once, the other arches share the first one's copy
+	from := loc
+	from.Arch = arches[0]
+	uerr = storeUpload(ctx.Request().Context(), from, up)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	auditUpload(ctx, from.Arch, up)
+	for _, arch := range arches[1:] {
+		to := loc
+		to.Arch = arch
+		err := copyRepoFile(ctx.Request().Context(), PackageBaseDirectory, from, to, up.name)
+		if err != nil {
+			log.Error().Err(err).Str("org", org).Str("repo", repo).Str("arch", arch).Str("file", up.name).Msg("failed to add noarch package to arch")
+			observeUploadRejection(org, "write_failed")
+			return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to store package for " + arch})
+		}
+		auditUpload(ctx, arch, up)
+	}
 
-	return ctx.JSON(http.StatusOK, &Package{Name: pkg.Name, Version: &pkg.Version})
+	for _, arch := range arches {
+		go func() {
+			_ = GenerateAPKIndex(PackageBaseDirectory, org, distro, ver, repo, arch)
+		}()
+	}
+	return ctx.JSON(http.StatusOK, &NoarchPackage{Name: up.pkg.Name, Version: up.pkg.Version, Arches: arches})
 }
 
 // CreatePackageIndex - regenerate the index for a repo
-func (p *PkgRepoAPI) CreatePackageIndex(ctx echo.Context, org, distro, ver, repo, arch string) error {
-	// generateAPKIndex is meant to be run in a goroutine, so we don't actually get any return from the function
+func (p *PkgRepoAPI) CreatePackageIndex(ctx echo.Context, org, distro, ver, repo, arch string, params CreatePackageIndexParams) error {
+	// virtual repos' indexes are ours, merged from their members
+	err := readOnlyRepoError(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: ver, Repo: repo})
+	if err != nil && !errors.Is(err, errVirtualRepo) {
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	}
+	if params.Wait != nil && *params.Wait {
+		err := GenerateAPKIndex(PackageBaseDirectory, org, distro, ver, repo, arch)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to generate index: " + err.Error()})
+		}
+		return ctx.JSON(http.StatusOK, &GenerateIndex{Status: true})
+	}
+
+	// when we aren't waiting, generateAPKIndex runs in a goroutine, so we don't actually get any return from the function
 	// for now, just blindly return true, but we should tidy this up later
-	go GenerateAPKIndex(PackageBaseDirectory, org, distro, ver, repo, arch)
+	go func() {
+		_ = GenerateAPKIndex(PackageBaseDirectory, org, distro, ver, repo, arch)
+	}()
 
 	status := &GenerateIndex{Status: true}
 	return ctx.JSON(http.StatusOK, status)
 }
 
 // ListDistros - return a list of the supported distros
-func (p *PkgRepoAPI) ListDistros(ctx echo.Context, org string) error {
+func (p *PkgRepoAPI) ListDistros(ctx echo.Context, org string, params ListDistrosParams) error {
 	distros, err := listDistros(org)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to listDistros")
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: "failed to get a list of distros"})
 	}
-	return ctx.JSON(http.StatusOK, distros)
+	return sendPage(ctx, distros, func(d Distribution) string { return d }, params.Limit, params.Cursor, params.IfNoneMatch)
 }
 
 // ListOrganizations - return a list of the organizations
 // TODO this should probably only be available to the server tokens
-func (p *PkgRepoAPI) ListOrganizations(ctx echo.Context) error {
+func (p *PkgRepoAPI) ListOrganizations(ctx echo.Context, params ListOrganizationsParams) error {
 	orgs := listOrgs()
-	return ctx.JSON(http.StatusOK, orgs)
+	return sendPage(ctx, orgs, func(o Organization) string { return *o.Name }, params.Limit, params.Cursor, params.IfNoneMatch)
 }
 
 // GetOrganization - get an org
@@ -210,12 +281,12 @@ func (p *PkgRepoAPI) GetOrganization(ctx echo.Context, org string) error {
 }
 
 // ListVersions - list of versions in an org's repo
-func (p *PkgRepoAPI) ListVersions(ctx echo.Context, org, distro string) error {
+func (p *PkgRepoAPI) ListVersions(ctx echo.Context, org, distro string, params ListVersionsParams) error {
 	dvs, err := listVersions(org, distro)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: "failed to list distro versions"})
 	}
-	return ctx.JSON(http.StatusOK, dvs)
+	return sendPage(ctx, dvs, func(v RepoVersion) string { return v }, params.Limit, params.Cursor, params.IfNoneMatch)
 }
 
 // GetOrgDistro - Return info about a distribution for an org
@@ -225,12 +296,12 @@ func (p *PkgRepoAPI) GetOrgDistro(ctx echo.Context, org, distro string) error {
 }
 
 // ListArches - list architectures for org/distro/version/repo
-func (p *PkgRepoAPI) ListArches(ctx echo.Context, org, distro, version, repo string) error {
+func (p *PkgRepoAPI) ListArches(ctx echo.Context, org, distro, version, repo string, params ListArchesParams) error {
 	arches, err := listArches(org, distro, version, repo)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: "failed to get arch list"})
 	}
 
-	return ctx.JSON(http.StatusOK, arches)
+	return sendPage(ctx, arches, func(a Architecture) string { return a }, params.Limit, params.Cursor, params.IfNoneMatch)
 
 }
@@ -1,5 +1,20 @@
 package api
 
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
 // TODO convert to use AFS's inmemory backend
 
 // import (
@@ -40,3 +55,93 @@ package api
 // 		End()
 //
 // }
+
+// uploadRequest - a CreatePackage style multipart upload of data as name
+func uploadRequest(t *testing.T, name string, data []byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("package", name)
+	if err != nil {
+		t.Fatal("failed to create test upload", err)
+	}
+	_, _ = part.Write(data)
+	_ = mw.Close()
+	req := httptest.NewRequest(http.MethodPost, "/", &body)
+	req.Header.Set(echo.HeaderContentType, mw.FormDataContentType())
+	return req
+}
+
+func TestCreateNoarchPackage(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-noarch-*")
+	if err != nil {
+		t.Fatal("failed to create testCreateNoarchPackage tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testCreateNoarchPackage tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+
+	_, err = createDistroKey(PackageBaseDirectory, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	repoDir := filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main")
+	e := echo.New()
+	papi := &PkgRepoAPI{}
+	foo := buildTestApk(t, "foo", "1.0-r0", "noarch")
+
+	// the repo has to be somewhere in the tree
+	rec := httptest.NewRecorder()
+	err = papi.CreateNoarchPackage(e.NewContext(uploadRequest(t, "foo-1.0-r0.apk", foo), rec), "testorg", "alpine", "..", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid repo")
+
+	// there has to be an arch to fan out to
+	rec = httptest.NewRecorder()
+	err = papi.CreateNoarchPackage(e.NewContext(uploadRequest(t, "foo-1.0-r0.apk", foo), rec), "testorg", "alpine", "edge", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	for _, arch := range []string{"x86_64", "aarch64"} {
+		err := os.MkdirAll(filepath.Join(repoDir, arch), 0755)
+		if err != nil {
+			t.Fatal("failed to create test arch dir", err)
+		}
+	}
+
+	// only noarch packages
+	rec = httptest.NewRecorder()
+	err = papi.CreateNoarchPackage(e.NewContext(uploadRequest(t, "bar-1.0-r0.apk", buildTestApk(t, "bar", "1.0-r0", "x86_64")), rec), "testorg", "alpine", "edge", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	err = papi.CreateNoarchPackage(e.NewContext(uploadRequest(t, "foo-1.0-r0.apk", foo), rec), "testorg", "alpine", "edge", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var result NoarchPackage
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, NoarchPackage{Name: "foo", Version: "1.0-r0", Arches: []string{"aarch64", "x86_64"}}, result)
+
+	// stored once, in both arches
+	x86, err := os.Stat(filepath.Join(repoDir, "x86_64", "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+	arm, err := os.Stat(filepath.Join(repoDir, "aarch64", "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+	assert.True(t, os.SameFile(x86, arm))
+
+	// and both indexes are regenerated, with the package's arch rewritten
+	for _, arch := range []string{"x86_64", "aarch64"} {
+		loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: arch}
+		assert.Eventually(t, func() bool {
+			pkgs, err := loadRepoIndex(PackageBaseDirectory, loc)
+			return err == nil && len(pkgs) == 1 && pkgs[0].Arch == arch
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxPageLimit - the largest page a client can ask for
+const maxPageLimit = 1000
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// the cursor is just the (base64'd) key of the last entry on the previous page
+// since everything is sorted by key, entries added or removed between requests don't make pages
+// skip or repeat anything that was already there
+func encodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errInvalidCursor
+	}
+	return string(key), nil
+}
+
+// paginate - sort items by key and return the page after cursor, along with the cursor for the
+// following page (empty if this is the last page)
+// a nil limit returns everything after the cursor
+func paginate[T any](items []T, key func(T) string, limit *int, cursor *string) ([]T, string, error) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(items[i]) < key(items[j])
+	})
+
+	start := 0
+	if cursor != nil && *cursor != "" {
+		after, err := decodeCursor(*cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		start = sort.Search(len(items), func(i int) bool {
+			return key(items[i]) > after
+		})
+	}
+	page := items[start:]
+
+	if limit == nil {
+		return page, "", nil
+	}
+	if *limit < 1 || *limit > maxPageLimit {
+		return nil, "", errors.New("invalid limit")
+	}
+	if len(page) <= *limit {
+		return page, "", nil
+	}
+	page = page[:*limit]
+	return page, encodeCursor(key(page[len(page)-1])), nil
+}
+
+// etagMatches - check an If-None-Match header value against an ETag
+func etagMatches(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// sendListing - send a page of a listing with its ETag and next cursor headers, or a 304 if the
+// client already has this exact page
+func sendListing(ctx echo.Context, page any, next string, ifNoneMatch *string) error {
+	body, err := json.Marshal(page)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to encode listing"})
+	}
+	sum := sha256.Sum256(append(body, next...))
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	ctx.Response().Header().Set("ETag", etag)
+	if next != "" {
+		ctx.Response().Header().Set("X-Next-Cursor", next)
+	}
+	if etagMatches(ifNoneMatch, etag) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+	return ctx.JSONBlob(http.StatusOK, body)
+}
+
+// sendPage - paginate items and send the result, covers the common case for the listing endpoints
+func sendPage[T any](ctx echo.Context, items []T, key func(T) string, limit *int, cursor *string, ifNoneMatch *string) error {
+	page, next, err := paginate(items, key, limit, cursor)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: err.Error()})
+	}
+	if page == nil {
+		// send [] rather than null
+		page = []T{}
+	}
+	return sendListing(ctx, page, next, ifNoneMatch)
+}
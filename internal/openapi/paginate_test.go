@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []string{"d", "b", "e", "a", "c"}
+	key := func(s string) string { return s }
+	limit := 2
+
+	page, next, err := paginate(items, key, &limit, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, page)
+	assert.NotEmpty(t, next)
+
+	page, next, err = paginate(items, key, &limit, &next)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, page)
+
+	page, next, err = paginate(items, key, &limit, &next)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e"}, page)
+	assert.Empty(t, next)
+
+	page, _, err = paginate(items, key, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, page, 5)
+
+	bad := "!!!"
+	_, _, err = paginate(items, key, &limit, &bad)
+	assert.Error(t, err)
+}
+
+func TestSendListingNotModified(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	err := sendListing(e.NewContext(req, rec), []string{"a", "b"}, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	err = sendListing(e.NewContext(req, rec), []string{"a", "b"}, "", &etag)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	err = sendListing(e.NewContext(req, rec), []string{"a", "b", "c"}, "", &etag)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+// RepoLocation - one org/distro/version/repo/arch directory under static/
+// empty fields act as wildcards when used as a filter for FindRepoLocations
+type RepoLocation struct {
+	Org     string
+	Distro  string
+	Version string
+	Repo    string
+	Arch    string
+}
+
+func (l RepoLocation) staticURI(basedir string) string {
+	return url.JoinUNC(basedir, "static", l.Org, l.Distro, l.Version, l.Repo, l.Arch)
+}
+
+// cachedIndex - the parsed contents of an APKINDEX.tar.gz along with the file info we use to
+// figure out if it has changed since we parsed it
+type cachedIndex struct {
+	modTime  time.Time
+	size     int64
+	packages []*repository.Package
+}
+
+// parsed APKINDEX files keyed by their URL
+// these are only reparsed when the file on disk changes, so searches don't have to untar every
+// index on each request
+var indexCache = struct {
+	sync.Mutex
+	entries map[string]cachedIndex
+}{entries: make(map[string]cachedIndex)}
+
+// loadRepoIndex - return the packages in the APKINDEX for a repo location
+// a location without an index returns an empty list rather than an error, since that's what a
+// freshly created repo looks like
+func loadRepoIndex(basedir string, loc RepoLocation) ([]*repository.Package, error) {
+	ctx := context.Background()
+	indexURI := url.JoinUNC(loc.staticURI(basedir), "APKINDEX.tar.gz")
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := cfs.Exists(ctx, indexURI)
+	if err != nil || !ex {
+		return nil, err
+	}
+	obj, err := cfs.Object(ctx, indexURI)
+	if err != nil {
+		return nil, err
+	}
+
+	indexCache.Lock()
+	cached, ok := indexCache.entries[indexURI]
+	indexCache.Unlock()
+	if ok && cached.modTime.Equal(obj.ModTime()) && cached.size == obj.Size() {
+		return cached.packages, nil
+	}
+
+	rdr, err := cfs.Open(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := rdr.Close()
+		if err != nil {
+			log.Debug().Err(err).Str("uri", indexURI).Msg("loadRepoIndex: failed to close index")
+		}
+	}()
+	apki, err := repository.IndexFromArchive(rdr)
+	if err != nil {
+		return nil, err
+	}
+
+	indexCache.Lock()
+	indexCache.entries[indexURI] = cachedIndex{modTime: obj.ModTime(), size: obj.Size(), packages: apki.Packages}
+	indexCache.Unlock()
+	log.Debug().Str("uri", indexURI).Int("packages", len(apki.Packages)).Msg("loadRepoIndex: parsed index")
+
+	return apki.Packages, nil
+}
+
+// listStaticDirs - list the names of the directories below static/<elements...>
+func listStaticDirs(basedir string, elements ...string) ([]string, error) {
+	result := []string{}
+	ctx := context.Background()
+	// this looks like it's hardcoding the scheme, but it's really just trying to duplicate the logic that afs.List() uses
+	dirURI := url.Normalize(url.JoinUNC(basedir, append([]string{"static"}, elements...)...), file.Scheme)
+	cfs := afs.New()
+	err := cfs.Init(ctx, basedir)
+	if err != nil {
+		return result, err
+	}
+	ex, err := cfs.Exists(ctx, dirURI)
+	if err != nil || !ex {
+		return result, err
+	}
+	list, err := cfs.List(ctx, dirURI)
+	if err != nil {
+		return result, err
+	}
+	for _, d := range list {
+		if d.URL() == dirURI || !d.IsDir() {
+			// we can skip the parent dir and files
+			continue
+		}
+		result = append(result, d.Name())
+	}
+	return result, nil
+}
+
+// FindRepoLocations - walk static/ and return every arch directory matching filter
+// this is exported for the cli, which works on a local copy of the tree
+func FindRepoLocations(basedir string, filter RepoLocation) ([]RepoLocation, error) {
+	var result []RepoLocation
+
+	// pick either the single value from the filter or everything on disk
+	expand := func(want string, elements ...string) ([]string, error) {
+		if want != "" {
+			return []string{want}, nil
+		}
+		return listStaticDirs(basedir, elements...)
+	}
+
+	orgs, err := expand(filter.Org)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		distros, err := expand(filter.Distro, org)
+		if err != nil {
+			return nil, err
+		}
+		for _, distro := range distros {
+			versions, err := expand(filter.Version, org, distro)
+			if err != nil {
+				return nil, err
+			}
+			for _, version := range versions {
+				repos, err := expand(filter.Repo, org, distro, version)
+				if err != nil {
+					return nil, err
+				}
+				for _, repo := range repos {
+					arches, err := expand(filter.Arch, org, distro, version, repo)
+					if err != nil {
+						return nil, err
+					}
+					for _, arch := range arches {
+						result = append(result, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch})
+					}
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
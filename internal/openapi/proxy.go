@@ -0,0 +1,272 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/yaml.v3"
+)
+
+// a proxy repo is a lighter weight mirror, it's set up with
+// config/<org>/<distro>/<version>/<repo>/proxy.yaml:
+//
+//	upstream: https://dl-cdn.alpinelinux.org/alpine/v3.20/main
+//	indexTTL: 15m
+//
+// and the upstream keys in mirror-keys/ like a mirror. nothing is fetched until it's asked for:
+// the index is fetched (and checked against the keys) when it's missing or older than indexTTL, and
+// packages are fetched the first time they're downloaded and checked against the index, then
+// cached under static/ like any other package
+
+const (
+	proxyConfigFile = "proxy.yaml"
+
+	defaultProxyIndexTTL = 5 * time.Minute
+)
+
+var (
+	errProxyRepo = errors.New("repo is a proxy for an upstream repo")
+	// errNoRepoFile - the file doesn't exist, and isn't in the upstream index (or a member's) either
+	errNoRepoFile = errors.New("no such file")
+)
+
+// errUpstream - fetching from upstream failed, as opposed to something going wrong locally
+type errUpstream struct {
+	err error
+}
+
+func (e errUpstream) Error() string { return e.err.Error() }
+func (e errUpstream) Unwrap() error { return e.err }
+
+// proxyFetches - fetches from upstream that are in progress, keyed by the local file's URI, so a
+// build farm asking for the same package all at once only fetches it once
+var proxyFetches singleflight.Group
+
+// proxyConfig - the contents of proxy.yaml
+type proxyConfig struct {
+	Upstream string        `yaml:"upstream"`
+	IndexTTL time.Duration `yaml:"indexTTL,omitempty"`
+}
+
+func (p proxyConfig) indexTTL() time.Duration {
+	if p.IndexTTL <= 0 {
+		return defaultProxyIndexTTL
+	}
+	return p.IndexTTL
+}
+
+// loadProxyConfig - read a repo's proxy.yaml, repos that aren't proxies return nil
+func loadProxyConfig(basedir string, loc RepoLocation) (*proxyConfig, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	uri := url.JoinUNC(mirrorConfigURI(basedir, loc), proxyConfigFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil || !ex {
+		return nil, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var cfg proxyConfig
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if cfg.Upstream == "" {
+		return nil, fmt.Errorf("%s doesn't have an upstream", uri)
+	}
+	return &cfg, nil
+}
+
+// refreshProxyIndex - fetch the upstream index for a proxy's arch if the cached one is missing
+// or stale, returning whether it was. a stale index is kept (and used) if upstream can't be reached
+func refreshProxyIndex(ctx context.Context, basedir string, loc RepoLocation, cfg proxyConfig) (bool, error) {
+	cfs := afs.New()
+	indexURI := url.JoinUNC(loc.staticURI(basedir), "APKINDEX.tar.gz")
+	ex, err := cfs.Exists(ctx, indexURI)
+	if err != nil {
+		return false, err
+	}
+	if ex {
+		obj, err := cfs.Object(ctx, indexURI)
+		if err != nil {
+			return false, err
+		}
+		if time.Since(obj.ModTime()) < cfg.indexTTL() {
+			return false, nil
+		}
+	}
+
+	// everyone waiting on the fetch shares it, so it shouldn't stop when the first client goes away
+	ctx = context.WithoutCancel(ctx)
+	_, err, _ = proxyFetches.Do(indexURI, func() (any, error) {
+		keys, err := loadUpstreamKeys(basedir, loc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load upstream keys: %w", err)
+		}
+		index, err := fetchUpstream(ctx, mirrorConfig{Upstream: cfg.Upstream}.archURL(loc.Arch, "APKINDEX.tar.gz"), maxMirrorIndexSize)
+		if err == nil {
+			_, err = verifyIndexSignature(index, keys)
+		}
+		if err != nil {
+			return nil, errUpstream{err}
+		}
+		_ = cfs.Delete(ctx, indexURI)
+		return nil, cfs.Upload(ctx, indexURI, 0644, bytes.NewReader(index))
+	})
+	if err != nil && ex {
+		log.Warn().Err(err).Str("uri", indexURI).Msg("failed to refresh proxy index, using the cached one")
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// fetchProxyPackage - fetch a package that's in the cached upstream index, checking it against
+// the index's checksum
+func fetchProxyPackage(ctx context.Context, basedir string, loc RepoLocation, cfg proxyConfig, name string) error {
+	pkgs, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		return err
+	}
+	var pkg *repository.Package
+	for _, p := range pkgs {
+		if p.Filename() == name {
+			pkg = p
+			break
+		}
+	}
+	if pkg == nil {
+		return errNoRepoFile
+	}
+	fileURI := url.JoinUNC(loc.staticURI(basedir), name)
+	ctx = context.WithoutCancel(ctx)
+	_, err, _ = proxyFetches.Do(fileURI, func() (any, error) {
+		limit := int64(maxMirrorPackageSize)
+		if pkg.Size > 0 {
+			limit = int64(pkg.Size)
+		}
+		// streamed into a staging file, which is removed if it doesn't match the index
+		staged, err := stageUpstreamPackage(ctx, basedir, mirrorConfig{Upstream: cfg.Upstream}.archURL(loc.Arch, name), name, limit, pkg.Checksum)
+		var staging errStaging
+		if errors.As(err, &staging) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, errUpstream{err}
+		}
+		return nil, commitRepoFile(ctx, basedir, loc, name, staged)
+	})
+	return err
+}
+
+// repoFile - make sure a file is available locally, fetching it from upstream for a proxy, and
+// return its URI (which is in a member's dir for a virtual repo's packages)
+func repoFile(ctx context.Context, basedir string, loc RepoLocation, name string) (string, error) {
+	virtual, err := loadVirtualConfig(basedir, loc)
+	if err != nil {
+		return "", err
+	}
+	if virtual != nil {
+		return virtualRepoFile(ctx, basedir, loc, *virtual, name)
+	}
+	cfg, err := loadProxyConfig(basedir, loc)
+	if err != nil {
+		return "", err
+	}
+	if cfg != nil && name == "APKINDEX.tar.gz" {
+		refreshed, err := refreshProxyIndex(ctx, basedir, loc, *cfg)
+		if err != nil {
+			return "", err
+		}
+		if refreshed {
+			go regenerateVirtualRepos(basedir, loc, nil)
+		}
+	}
+	uri, err := resolveRepoFile(ctx, basedir, loc, name)
+	if err == nil {
+		if cfg != nil {
+			observeProxyRequest(loc.Org, "hit")
+		}
+		return uri, nil
+	}
+	if !errors.Is(err, errNoRepoFile) {
+		return "", err
+	}
+	if cfg == nil || !strings.HasSuffix(name, ".apk") {
+		return "", errNoRepoFile
+	}
+	// packages are only fetched if they're in the index, so make sure there is one
+	_, err = refreshProxyIndex(ctx, basedir, loc, *cfg)
+	if err == nil {
+		err = fetchProxyPackage(ctx, basedir, loc, *cfg, name)
+	}
+	if err != nil {
+		observeProxyRequest(loc.Org, "error")
+		return "", err
+	}
+	observeProxyRequest(loc.Org, "miss")
+	return resolveRepoFile(ctx, basedir, loc, name)
+}
+
+// validPathSegments - whether none of the segments of a file's path can point outside the repo,
+// the last one is the file name, which can't be hidden either
+func validPathSegments(segs ...string) bool {
+	for i, seg := range segs {
+		if seg == "" || seg == "." || seg == ".." || strings.ContainsAny(seg, `/\`) {
+			return false
+		}
+		if i == len(segs)-1 && strings.HasPrefix(seg, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// streamFile - send a repo file to the client
+func streamFile(ctx echo.Context, uri string) error {
+	cfs := afs.New()
+	rdr, err := cfs.OpenURL(ctx.Request().Context(), uri)
+	if err != nil {
+		log.Error().Err(err).Str("uri", uri).Msg("failed to open repo file")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to get file"})
+	}
+	defer func() {
+		_ = rdr.Close()
+	}()
+	return ctx.Stream(http.StatusOK, "application/octet-stream", rdr)
+}
+
+// GetRepoFile - download a package or index from a repo, proxies fetch it from upstream first and
+// virtual repos serve it from the member it came from
+func (p *PkgRepoAPI) GetRepoFile(ctx echo.Context, org, distro, version, repo, arch, name string) error {
+	// these all end up in a path, make sure none of them can point outside the repo
+	if !validPathSegments(org, distro, version, repo, arch, name) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "no such file"})
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	uri, err := repoFile(ctx.Request().Context(), PackageBaseDirectory, loc, name)
+	var upErr errUpstream
+	switch {
+	case errors.Is(err, errNoRepoFile):
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "no such file"})
+	case errors.As(err, &upErr):
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("arch", arch).Str("file", name).Msg("failed to fetch from upstream")
+		return ctx.JSON(http.StatusBadGateway, Error{Code: http.StatusBadGateway, Message: "failed to fetch from upstream: " + err.Error()})
+	case err != nil:
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("arch", arch).Str("file", name).Msg("failed to get repo file")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to get file"})
+	}
+	return streamFile(ctx, uri)
+}
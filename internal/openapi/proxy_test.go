@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyRepo(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-proxy-*")
+	if err != nil {
+		t.Fatal("failed to create testProxyRepo tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testProxyRepo tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+
+	key, err := rsa.GenerateKey(rand.Reader, minKeyBits)
+	if err != nil {
+		t.Fatal("failed to generate test key", err)
+	}
+	upstream := &testUpstream{t: t, key: key, files: map[string][]byte{}}
+	srv := httptest.NewServer(upstream)
+	defer srv.Close()
+
+	// a proxy is set up like a mirror, with proxy.yaml instead of mirror.yaml
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "v3.20", Repo: "main", Arch: "x86_64"}
+	writeMirrorConfig(t, tmpDir, loc, srv.URL, &key.PublicKey)
+	configDir := filepath.Join(tmpDir, "config", "testorg", "alpine", "v3.20", "main")
+	err = os.Remove(filepath.Join(configDir, mirrorConfigFile))
+	if err != nil {
+		t.Fatal("failed to remove test mirror config", err)
+	}
+	err = os.WriteFile(filepath.Join(configDir, proxyConfigFile), []byte("upstream: "+srv.URL+"\nindexTTL: 1h\n"), 0644)
+	if err != nil {
+		t.Fatal("failed to write test proxy config", err)
+	}
+	staticDir := filepath.Join(tmpDir, "static", "testorg", "alpine", "v3.20", "main", "x86_64")
+
+	foo := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	bar := buildTestApk(t, "bar", "2.0-r0", "x86_64")
+	upstream.publish("x86_64", map[string][]byte{"foo 1.0-r0": foo, "bar 2.0-r0": bar})
+	assert.True(t, errors.Is(readOnlyRepoError(basedir, loc), errProxyRepo))
+
+	// asking for a package fetches the index first, then just that package
+	uri, err := repoFile(context.Background(), basedir, loc, "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(staticDir, "foo-1.0-r0.apk"), uri)
+	data, err := os.ReadFile(filepath.Join(staticDir, "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	assert.FileExists(t, filepath.Join(staticDir, "APKINDEX.tar.gz"))
+	assert.NoFileExists(t, filepath.Join(staticDir, "bar-2.0-r0.apk"))
+
+	// anything that isn't in the upstream index isn't fetched
+	_, err = repoFile(context.Background(), basedir, loc, "baz-1.0-r0.apk")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+	_, err = repoFile(context.Background(), basedir, loc, "README")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+
+	// a package that doesn't match the index isn't cached
+	upstream.files["x86_64/bar-2.0-r0.apk"] = foo
+	_, err = repoFile(context.Background(), basedir, loc, "bar-2.0-r0.apk")
+	var upErr errUpstream
+	assert.True(t, errors.As(err, &upErr))
+	assert.NoFileExists(t, filepath.Join(staticDir, "bar-2.0-r0.apk"))
+	staging, err := os.ReadDir(filepath.Join(tmpDir, uploadsDir))
+	assert.NoError(t, err)
+	assert.Empty(t, staging)
+
+	// cached files are served while upstream is down, even a stale index
+	srv.Close()
+	stale := time.Now().Add(-2 * time.Hour)
+	err = os.Chtimes(filepath.Join(staticDir, "APKINDEX.tar.gz"), stale, stale)
+	if err != nil {
+		t.Fatal("failed to age test index", err)
+	}
+	_, err = repoFile(context.Background(), basedir, loc, "APKINDEX.tar.gz")
+	assert.NoError(t, err)
+	_, err = repoFile(context.Background(), basedir, loc, "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+}
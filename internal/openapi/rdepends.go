@@ -0,0 +1,157 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+const maxReverseDependencyDepth = 10
+
+// locatedPackage - a package from an index along with where it came from
+type locatedPackage struct {
+	loc RepoLocation
+	pkg *repository.Package
+}
+
+// reverseDependencies - find the packages whose dependencies resolve to name (a package name or a
+// provides entry), following the chain back up to depth levels
+// dependencies are resolved between all the repos of the same distro/version/arch, the same way
+// a system with all of those repos enabled would see them
+func reverseDependencies(basedir string, filter RepoLocation, name string, depth int) ([]ReverseDependency, error) {
+	result := []ReverseDependency{}
+	locs, err := FindRepoLocations(basedir, filter)
+	if err != nil {
+		return result, err
+	}
+
+	groups := map[string][]locatedPackage{}
+	for _, loc := range locs {
+		pkgs, err := loadRepoIndex(basedir, loc)
+		if err != nil {
+			log.Warn().Err(err).Interface("location", loc).Msg("reverseDependencies: failed to load index")
+			continue
+		}
+		key := strings.Join([]string{loc.Distro, loc.Version, loc.Arch}, "/")
+		for _, pkg := range pkgs {
+			groups[key] = append(groups[key], locatedPackage{loc: loc, pkg: pkg})
+		}
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		result = append(result, groupReverseDependencies(groups[k], name, depth)...)
+	}
+	return result, nil
+}
+
+func groupReverseDependencies(group []locatedPackage, name string, depth int) []ReverseDependency {
+	var result []ReverseDependency
+
+	providers := providerMap{}
+	located := map[*repository.Package]RepoLocation{}
+	for _, lp := range group {
+		providers.add(lp.pkg, true)
+		located[lp.pkg] = lp.loc
+	}
+
+	// dependents[x] - the packages with a dependency that x satisfies, and that dependency
+	type dependent struct {
+		pkg *repository.Package
+		dep string
+	}
+	dependents := map[string][]dependent{}
+	for _, lp := range group {
+		for _, dep := range lp.pkg.Dependencies {
+			d := parseDependency(dep)
+			if d.conflict {
+				continue
+			}
+			seen := map[string]bool{}
+			for _, p := range providers.satisfying(d) {
+				if p.pkg.Name == lp.pkg.Name || seen[p.pkg.Name] {
+					continue
+				}
+				seen[p.pkg.Name] = true
+				dependents[p.pkg.Name] = append(dependents[p.pkg.Name], dependent{pkg: lp.pkg, dep: dep})
+			}
+		}
+	}
+
+	// the starting point is every package that is, or provides, name
+	visited := map[string]bool{}
+	var frontier []string
+	for _, p := range providers[name] {
+		if !visited[p.pkg.Name] {
+			visited[p.pkg.Name] = true
+			frontier = append(frontier, p.pkg.Name)
+		}
+	}
+	sort.Strings(frontier)
+
+	reported := map[*repository.Package]bool{}
+	for level := 1; level <= depth && len(frontier) > 0; level++ {
+		var next []string
+		for _, target := range frontier {
+			for _, d := range dependents[target] {
+				if reported[d.pkg] {
+					continue
+				}
+				reported[d.pkg] = true
+				loc := located[d.pkg]
+				result = append(result, ReverseDependency{
+					Name:          d.pkg.Name,
+					Version:       d.pkg.Version,
+					Distro:        loc.Distro,
+					DistroVersion: loc.Version,
+					Repo:          loc.Repo,
+					Arch:          loc.Arch,
+					Depth:         level,
+					Dependency:    d.dep,
+					Via:           target,
+				})
+				if !visited[d.pkg.Name] {
+					visited[d.pkg.Name] = true
+					next = append(next, d.pkg.Name)
+				}
+			}
+		}
+		sort.Strings(next)
+		frontier = next
+	}
+
+	return result
+}
+
+// ListReverseDependencies - list the packages that depend on a package name or provides entry
+func (p *PkgRepoAPI) ListReverseDependencies(ctx echo.Context, org string, params ListReverseDependenciesParams) error {
+	filter := RepoLocation{Org: org}
+	if params.Distro != nil {
+		filter.Distro = *params.Distro
+	}
+	if params.DistroVersion != nil {
+		filter.Version = *params.DistroVersion
+	}
+	depth := 1
+	if params.Depth != nil {
+		depth = *params.Depth
+	}
+	if depth < 1 || depth > maxReverseDependencyDepth {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid depth"})
+	}
+
+	rdeps, err := reverseDependencies(PackageBaseDirectory, filter, params.Name, depth)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("name", params.Name).Msg("failed to list reverse dependencies")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to list reverse dependencies"})
+	}
+	return ctx.JSON(http.StatusOK, rdeps)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+func TestReverseDependencies(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-rdepends-*")
+	if err != nil {
+		t.Fatal("failed to create testReverseDependencies tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testReverseDependencies tmpDir", err)
+		}
+	}()
+
+	mainRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	testingRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "testing", Arch: "x86_64"}
+	writeTestIndex(t, tmpDir, mainRepo, []*repository.Package{
+		{Name: "libfoo", Version: "1.0-r0", Provides: []string{"so:libfoo.so.1=1"}},
+		{Name: "foo", Version: "1.0-r0", Dependencies: []string{"so:libfoo.so.1"}},
+		{Name: "unrelated", Version: "1.0-r0"},
+	})
+	writeTestIndex(t, tmpDir, testingRepo, []*repository.Package{
+		{Name: "foo-plugin", Version: "1.0-r0", Dependencies: []string{"foo>=1"}},
+	})
+
+	basedir := "file://" + tmpDir
+
+	rdeps, err := reverseDependencies(basedir, RepoLocation{Org: "testorg"}, "libfoo", 1)
+	assert.NoError(t, err)
+	assert.Len(t, rdeps, 1)
+	assert.Equal(t, "foo", rdeps[0].Name)
+	assert.Equal(t, "so:libfoo.so.1", rdeps[0].Dependency)
+
+	rdeps, err = reverseDependencies(basedir, RepoLocation{Org: "testorg"}, "so:libfoo.so.1", 2)
+	assert.NoError(t, err)
+	assert.Len(t, rdeps, 2)
+	assert.Equal(t, "foo-plugin", rdeps[1].Name)
+	assert.Equal(t, "testing", rdeps[1].Repo)
+	assert.Equal(t, 2, rdeps[1].Depth)
+	assert.Equal(t, "foo", rdeps[1].Via)
+
+	rdeps, err = reverseDependencies(basedir, RepoLocation{Org: "testorg"}, "unrelated", 3)
+	assert.NoError(t, err)
+	assert.Empty(t, rdeps)
+}
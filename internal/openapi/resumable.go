@@ -0,0 +1,502 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gopkg.in/yaml.v3"
+)
+
+// a resumable upload is a session in uploads/<org>/<id>/: session.yaml says what's being uploaded
+// where, and each chunk is a file named by its offset. a chunk is staged like any other upload and
+// only added to the session once it's all arrived, so after a failure the client asks how much
+// there is and carries on from there. finalize streams the chunks back through stageUpload, so
+// the package is checked exactly like a single request upload before it goes anywhere near the repo
+
+const (
+	uploadSessionFile = "session.yaml"
+	// uploadSessionTTL - how long a session or transaction (or a staging file a crash left behind)
+	// is kept without anything arriving
+	uploadSessionTTL = 24 * time.Hour
+	// uploadCleanupInterval - how often expired sessions are looked for
+	uploadCleanupInterval = time.Hour
+)
+
+var (
+	errUploadSessionNotFound = errors.New("no such upload session")
+	errUploadOffset          = errors.New("offset isn't how much of the upload has arrived")
+	errUploadRead            = errors.New("failed to read chunk")
+
+	uploadSessionIDPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	// uploadSessionsLock - session.yaml and transaction.yaml files are read, changed and written
+	// back one at a time. uploads are staged without it, it's only held while one is added
+	uploadSessionsLock sync.Mutex
+)
+
+// uploadSession - the contents of session.yaml
+type uploadSession struct {
+	ID      string        `yaml:"id"`
+	Distro  string        `yaml:"distro"`
+	Version string        `yaml:"version"`
+	Repo    string        `yaml:"repo"`
+	Arch    string        `yaml:"arch"`
+	File    string        `yaml:"file"`
+	Size    int64         `yaml:"size,omitempty"`
+	Chunks  []uploadChunk `yaml:"chunks"`
+	Expires time.Time     `yaml:"expires"`
+}
+
+// uploadChunk - a chunk that's arrived
+type uploadChunk struct {
+	Offset int64 `yaml:"offset"`
+	Size   int64 `yaml:"size"`
+}
+
+// offset - how much of the upload has arrived
+func (s uploadSession) offset() int64 {
+	if len(s.Chunks) == 0 {
+		return 0
+	}
+	last := s.Chunks[len(s.Chunks)-1]
+	return last.Offset + last.Size
+}
+
+// matches - whether the session is for loc, the id alone doesn't say which repo it's for
+func (s uploadSession) matches(loc RepoLocation) bool {
+	return s.Distro == loc.Distro && s.Version == loc.Version && s.Repo == loc.Repo && s.Arch == loc.Arch
+}
+
+func uploadSessionURI(basedir, org, id string) string {
+	return url.JoinUNC(basedir, uploadsDir, org, id)
+}
+
+func uploadChunkURI(basedir, org, id string, offset int64) string {
+	return url.JoinUNC(uploadSessionURI(basedir, org, id), fmt.Sprintf("%020d", offset))
+}
+
+// loadUploadSession - read a session, ones that expired or are for a different repo don't exist
+func loadUploadSession(ctx context.Context, basedir string, loc RepoLocation, id string) (*uploadSession, error) {
+	if !uploadSessionIDPattern.MatchString(id) {
+		return nil, errUploadSessionNotFound
+	}
+	cfs := afs.New()
+	uri := url.JoinUNC(uploadSessionURI(basedir, loc.Org, id), uploadSessionFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if !ex {
+		return nil, errUploadSessionNotFound
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var s uploadSession
+	err = yaml.Unmarshal(data, &s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if !s.matches(loc) || time.Now().After(s.Expires) {
+		return nil, errUploadSessionNotFound
+	}
+	return &s, nil
+}
+
+// saveUploadSession - write a session's session.yaml
+func saveUploadSession(ctx context.Context, basedir, org string, s uploadSession) error {
+	cfs := afs.New()
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	uri := url.JoinUNC(uploadSessionURI(basedir, org, s.ID), uploadSessionFile)
+	_ = cfs.Delete(ctx, uri)
+	return cfs.Upload(ctx, uri, 0644, bytes.NewReader(data))
+}
+
+// createUploadSession - start a session for uploading name to loc
+func createUploadSession(ctx context.Context, basedir string, loc RepoLocation, name string, size int64) (*uploadSession, error) {
+	var rnd [16]byte
+	_, _ = rand.Read(rnd[:])
+	s := uploadSession{
+		ID:      hex.EncodeToString(rnd[:]),
+		Distro:  loc.Distro,
+		Version: loc.Version,
+		Repo:    loc.Repo,
+		Arch:    loc.Arch,
+		File:    name,
+		Size:    size,
+		Chunks:  []uploadChunk{},
+		Expires: time.Now().UTC().Add(uploadSessionTTL),
+	}
+	err := saveUploadSession(ctx, basedir, loc.Org, s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// addUploadChunk - stage r and add it to the session as the chunk at offset. offset has to be how
+// much has already arrived, both before and after it's staged, since another request could have
+// added the same chunk in the meantime
+func addUploadChunk(ctx context.Context, basedir string, loc RepoLocation, id string, offset int64, r io.Reader) (*uploadSession, error) {
+	s, err := loadUploadSession(ctx, basedir, loc, id)
+	if err != nil {
+		return nil, err
+	}
+	if offset != s.offset() {
+		return s, errUploadOffset
+	}
+	cfs := afs.New()
+	staged, err := stageFile(ctx, cfs, basedir, r, nil)
+	if err != nil {
+		var staging errStaging
+		var tooLarge *http.MaxBytesError
+		if !errors.As(err, &staging) && !errors.As(err, &tooLarge) {
+			err = fmt.Errorf("%w: %w", errUploadRead, err)
+		}
+		return s, err
+	}
+
+	uploadSessionsLock.Lock()
+	defer uploadSessionsLock.Unlock()
+	s, err = loadUploadSession(ctx, basedir, loc, id)
+	if err == nil && offset != s.offset() {
+		err = errUploadOffset
+	}
+	if err == nil && staged.size > 0 {
+		err = cfs.Move(ctx, staged.uri, uploadChunkURI(basedir, loc.Org, id, offset))
+		if err == nil {
+			s.Chunks = append(s.Chunks, uploadChunk{Offset: offset, Size: staged.size})
+		}
+	}
+	if err != nil || staged.size == 0 {
+		_ = cfs.Delete(ctx, staged.uri)
+	}
+	if err != nil {
+		return s, err
+	}
+	s.Expires = time.Now().UTC().Add(uploadSessionTTL)
+	return s, saveUploadSession(ctx, basedir, loc.Org, *s)
+}
+
+// deleteUploadSession - remove a session and its chunks
+func deleteUploadSession(ctx context.Context, basedir, org, id string) error {
+	uploadSessionsLock.Lock()
+	defer uploadSessionsLock.Unlock()
+	return afs.New().Delete(ctx, uploadSessionURI(basedir, org, id))
+}
+
+// chunksReader - the session's chunks, one after the other, each only opened once it's needed
+type chunksReader struct {
+	ctx     context.Context
+	cfs     afs.Service
+	basedir string
+	org     string
+	s       uploadSession
+	current io.ReadCloser
+}
+
+func (c *chunksReader) Read(p []byte) (int, error) {
+	for {
+		if c.current == nil {
+			if len(c.s.Chunks) == 0 {
+				return 0, io.EOF
+			}
+			rc, err := c.cfs.OpenURL(c.ctx, uploadChunkURI(c.basedir, c.org, c.s.ID, c.s.Chunks[0].Offset))
+			if err != nil {
+				return 0, err
+			}
+			c.current = rc
+			c.s.Chunks = c.s.Chunks[1:]
+		}
+		n, err := c.current.Read(p)
+		if err == io.EOF {
+			_ = c.current.Close()
+			c.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (c *chunksReader) Close() error {
+	if c.current == nil {
+		return nil
+	}
+	return c.current.Close()
+}
+
+// CleanUploads - remove expired upload sessions and transactions, and staging files that have been left behind
+// (by a crash, say) for longer than a session would be, returning how many were removed
+func CleanUploads(ctx context.Context, basedir string) (int, error) {
+	cfs := afs.New()
+	dir := url.Normalize(url.JoinUNC(basedir, uploadsDir), file.Scheme)
+	ex, err := cfs.Exists(ctx, dir)
+	if err != nil || !ex {
+		return 0, err
+	}
+	list, err := cfs.List(ctx, dir)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, f := range list {
+		if f.URL() == dir {
+			continue
+		}
+		if !f.IsDir() {
+			if time.Since(f.ModTime()) > uploadSessionTTL {
+				err := cfs.Delete(ctx, f.URL())
+				if err != nil {
+					log.Error().Err(err).Str("uri", f.URL()).Msg("failed to remove stale staging file")
+					continue
+				}
+				removed++
+			}
+			continue
+		}
+		n, err := cleanOrgUploads(ctx, cfs, basedir, f.Name())
+		removed += n
+		if err != nil {
+			log.Error().Err(err).Str("org", f.Name()).Msg("failed to clean up upload sessions")
+		}
+	}
+	return removed, nil
+}
+
+// cleanOrgUploads - remove an org's expired upload sessions and transactions
+func cleanOrgUploads(ctx context.Context, cfs afs.Service, basedir, org string) (int, error) {
+	dir := url.Normalize(url.JoinUNC(basedir, uploadsDir, org), file.Scheme)
+	list, err := cfs.List(ctx, dir)
+	if err != nil {
+		return 0, err
+	}
+	uploadSessionsLock.Lock()
+	defer uploadSessionsLock.Unlock()
+	removed := 0
+	for _, f := range list {
+		if f.URL() == dir || !f.IsDir() {
+			continue
+		}
+		expired := time.Since(f.ModTime()) > uploadSessionTTL
+		// it's either an upload session or a transaction, they both say when they expire
+		for _, name := range []string{uploadSessionFile, transactionFile} {
+			data, err := cfs.DownloadWithURL(ctx, url.JoinUNC(f.URL(), name))
+			if err != nil {
+				continue
+			}
+			var s struct {
+				Expires time.Time `yaml:"expires"`
+			}
+			if yaml.Unmarshal(data, &s) == nil {
+				expired = time.Now().After(s.Expires)
+			}
+			break
+		}
+		if !expired {
+			continue
+		}
+		err = cfs.Delete(ctx, f.URL())
+		if err != nil {
+			log.Error().Err(err).Str("uri", f.URL()).Msg("failed to remove expired upload session")
+			continue
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// RunUploadCleanup - clean up expired uploads every uploadCleanupInterval until ctx is done
+func RunUploadCleanup(ctx context.Context, basedir string) {
+	ticker := time.NewTicker(uploadCleanupInterval)
+	defer ticker.Stop()
+	for {
+		removed, err := CleanUploads(ctx, basedir)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to clean up uploads")
+		} else if removed > 0 {
+			log.Info().Int("removed", removed).Msg("cleaned up expired uploads")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func uploadSessionFrom(s uploadSession) UploadSession {
+	session := UploadSession{Id: s.ID, File: s.File, Offset: s.offset(), Expires: s.Expires}
+	if s.Size > 0 {
+		session.Size = &s.Size
+	}
+	return session
+}
+
+// uploadSessionError - the response for an error loading or changing a session
+func uploadSessionError(ctx echo.Context, loc RepoLocation, id string, err error) error {
+	var tooLarge *http.MaxBytesError
+	var staging errStaging
+	switch {
+	case errors.Is(err, errUploadSessionNotFound):
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	case errors.Is(err, errUploadOffset):
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	case errors.As(err, &tooLarge):
+		return ctx.JSON(http.StatusRequestEntityTooLarge, Error{Code: http.StatusRequestEntityTooLarge, Message: "upload is bigger than allowed"})
+	case errors.As(err, &staging):
+		log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Str("session", id).Msg("failed to stage upload chunk")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to store chunk"})
+	case errors.Is(err, errUploadRead):
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: errUploadRead.Error()})
+	default:
+		log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Str("session", id).Msg("upload session failed")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "upload session failed"})
+	}
+}
+
+// CreateUploadSession - start a resumable upload
+func (p *PkgRepoAPI) CreateUploadSession(ctx echo.Context, org, distro, version, repo, arch string) error {
+	var req NewUploadSession
+	err := ctx.Bind(&req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid request"})
+	}
+	if !validPathSegments(org, distro, version, repo, arch) || !validUploadName(req.File) {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "bad_name", "packages have to be .apk files"})
+	}
+	var size int64
+	if req.Size != nil {
+		size = *req.Size
+	}
+	if size > MaxUploadSize {
+		return rejectUpload(ctx, org, uploadError{http.StatusRequestEntityTooLarge, "too_large", "upload is bigger than the server allows"})
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	err = readOnlyRepoError(PackageBaseDirectory, loc)
+	if err != nil {
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "read_only_repo", err.Error()})
+	}
+	s, err := createUploadSession(ctx.Request().Context(), PackageBaseDirectory, loc, req.File, size)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("arch", arch).Msg("failed to create upload session")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to create upload session"})
+	}
+	return ctx.JSON(http.StatusCreated, uploadSessionFrom(*s))
+}
+
+// GetUploadSession - how much of a resumable upload has arrived
+func (p *PkgRepoAPI) GetUploadSession(ctx echo.Context, org, distro, version, repo, arch, id string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	s, err := loadUploadSession(ctx.Request().Context(), PackageBaseDirectory, loc, id)
+	if err != nil {
+		return uploadSessionError(ctx, loc, id, err)
+	}
+	return ctx.JSON(http.StatusOK, uploadSessionFrom(*s))
+}
+
+// UploadChunk - add the next chunk to a resumable upload
+func (p *PkgRepoAPI) UploadChunk(ctx echo.Context, org, distro, version, repo, arch, id string, params UploadChunkParams) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	reqCtx := ctx.Request().Context()
+	s, err := loadUploadSession(reqCtx, PackageBaseDirectory, loc, id)
+	if err != nil {
+		return uploadSessionError(ctx, loc, id, err)
+	}
+	// the whole upload has to fit in the max upload size (and the size it was started with)
+	limit := MaxUploadSize
+	if s.Size > 0 {
+		limit = min(limit, s.Size)
+	}
+	req := ctx.Request()
+	if req.ContentLength > limit-params.Offset {
+		return ctx.JSON(http.StatusRequestEntityTooLarge, Error{Code: http.StatusRequestEntityTooLarge, Message: "upload is bigger than allowed"})
+	}
+	body := http.MaxBytesReader(ctx.Response(), req.Body, max(limit-params.Offset, 0))
+	s, err = addUploadChunk(reqCtx, PackageBaseDirectory, loc, id, params.Offset, body)
+	if err != nil {
+		return uploadSessionError(ctx, loc, id, err)
+	}
+	return ctx.JSON(http.StatusOK, uploadSessionFrom(*s))
+}
+
+// DeleteUploadSession - abandon a resumable upload
+func (p *PkgRepoAPI) DeleteUploadSession(ctx echo.Context, org, distro, version, repo, arch, id string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	reqCtx := ctx.Request().Context()
+	_, err := loadUploadSession(reqCtx, PackageBaseDirectory, loc, id)
+	if err == nil {
+		err = deleteUploadSession(reqCtx, PackageBaseDirectory, org, id)
+	}
+	if err != nil {
+		return uploadSessionError(ctx, loc, id, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// FinalizeUpload - check a resumable upload and add it to the repo
+func (p *PkgRepoAPI) FinalizeUpload(ctx echo.Context, org, distro, version, repo, arch, id string) error {
+	var req FinalizeUpload
+	err := ctx.Bind(&req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid request"})
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	reqCtx := ctx.Request().Context()
+	s, err := loadUploadSession(reqCtx, PackageBaseDirectory, loc, id)
+	if err != nil {
+		return uploadSessionError(ctx, loc, id, err)
+	}
+	err = readOnlyRepoError(PackageBaseDirectory, loc)
+	if err != nil {
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "read_only_repo", err.Error()})
+	}
+	if s.Size > 0 && s.offset() != s.Size {
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "incomplete", fmt.Sprintf("only %d of %d bytes have arrived", s.offset(), s.Size)})
+	}
+
+	chunks := &chunksReader{ctx: reqCtx, cfs: afs.New(), basedir: PackageBaseDirectory, org: org, s: *s}
+	up, uerr := stageUpload(reqCtx, PackageBaseDirectory, s.File, chunks)
+	_ = chunks.Close()
+	if uerr == nil && up.staged.sha256 != req.Sha256 {
+		_ = afs.New().Delete(reqCtx, up.staged.uri)
+		uerr = &uploadError{http.StatusBadRequest, "checksum_mismatch", fmt.Sprintf("the upload's sha256 is %s, not %s", up.staged.sha256, req.Sha256)}
+	}
+	if uerr != nil {
+		// there's no point keeping something that isn't what the client meant to send, the upload
+		// has to start again
+		if uerr.code == http.StatusBadRequest {
+			_ = deleteUploadSession(reqCtx, PackageBaseDirectory, org, id)
+		}
+		return rejectUpload(ctx, org, *uerr)
+	}
+	uerr = storeUpload(reqCtx, loc, up)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	auditUpload(ctx, arch, up)
+	err = deleteUploadSession(reqCtx, PackageBaseDirectory, org, id)
+	if err != nil {
+		log.Warn().Err(err).Str("org", org).Str("session", id).Msg("failed to remove finished upload session")
+	}
+	return ctx.JSON(http.StatusOK, &Package{Name: up.pkg.Name, Version: &up.pkg.Version})
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumableUpload(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-resumable-*")
+	if err != nil {
+		t.Fatal("failed to create testResumableUpload tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testResumableUpload tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+
+	e := echo.New()
+	papi := &PkgRepoAPI{}
+	foo := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	sum := sha256.Sum256(foo)
+	const org, distro, version, repo, arch = "testorg", "alpine", "edge", "main", "x86_64"
+	jsonRequest := func(method, body string) *http.Request {
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		return req
+	}
+	start := func(body string) UploadSession {
+		rec := httptest.NewRecorder()
+		err := papi.CreateUploadSession(e.NewContext(jsonRequest(http.MethodPost, body), rec), org, distro, version, repo, arch)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var s UploadSession
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &s))
+		return s
+	}
+	chunk := func(id string, offset int64, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/", body)
+		req.Header.Set(echo.HeaderContentType, "application/octet-stream")
+		err := papi.UploadChunk(e.NewContext(req, rec), org, distro, version, repo, arch, id, UploadChunkParams{Offset: offset})
+		assert.NoError(t, err)
+		return rec
+	}
+	finalize := func(id, sum string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		err := papi.FinalizeUpload(e.NewContext(jsonRequest(http.MethodPost, `{"sha256":"`+sum+`"}`), rec), org, distro, version, repo, arch, id)
+		assert.NoError(t, err)
+		return rec
+	}
+	progress := func(id string) (int, UploadSession) {
+		rec := httptest.NewRecorder()
+		err := papi.GetUploadSession(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), org, distro, version, repo, arch, id)
+		assert.NoError(t, err)
+		var s UploadSession
+		_ = json.Unmarshal(rec.Body.Bytes(), &s)
+		return rec.Code, s
+	}
+
+	s := start(`{"file":"foo-1.0-r0.apk","size":` + strconv.Itoa(len(foo)) + `}`)
+	assert.Equal(t, int64(0), s.Offset)
+	half := int64(len(foo) / 2)
+
+	// the first chunk arrives, the second fails part way and has to be resent
+	rec := chunk(s.Id, 0, bytes.NewReader(foo[:half]))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = chunk(s.Id, half, &brokenReader{bytes.NewReader(foo[half : half+10])})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	code, s := progress(s.Id)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, half, s.Offset)
+	// chunks only go on the end
+	rec = chunk(s.Id, half+10, bytes.NewReader(foo[half+10:]))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	// and not past the size it was started with
+	rec = chunk(s.Id, half, io.MultiReader(bytes.NewReader(foo[half:]), strings.NewReader("extra")))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	// not all of it's there yet
+	rec = finalize(s.Id, hex.EncodeToString(sum[:]))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	rec = chunk(s.Id, half, bytes.NewReader(foo[half:]))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// sessions are per repo
+	rec = httptest.NewRecorder()
+	err = papi.GetUploadSession(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), org, distro, version, "community", arch, s.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = finalize(s.Id, hex.EncodeToString(sum[:]))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	data, err := os.ReadFile(filepath.Join(tmpDir, "static", org, distro, version, repo, arch, "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	code, _ = progress(s.Id)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// a checksum that doesn't match ends the session without touching the repo
+	s = start(`{"file":"bar-1.0-r0.apk"}`)
+	rec = chunk(s.Id, 0, bytes.NewReader(foo))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = httptest.NewRecorder()
+	c := e.NewContext(jsonRequest(http.MethodPost, `{"sha256":"`+strings.Repeat("0", 64)+`"}`), rec)
+	assert.NoError(t, papi.FinalizeUpload(c, org, distro, version, repo, arch, s.Id))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	// and it isn't in the audit log as something that was written
+	assert.Nil(t, c.Get(auditFilesKey))
+	code, _ = progress(s.Id)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.NoFileExists(t, filepath.Join(tmpDir, "static", org, distro, version, repo, arch, "bar-1.0-r0.apk"))
+
+	// expired sessions are cleaned up
+	s = start(`{"file":"baz-1.0-r0.apk"}`)
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	session, err := loadUploadSession(context.Background(), PackageBaseDirectory, loc, s.Id)
+	assert.NoError(t, err)
+	session.Expires = time.Now().Add(-time.Minute)
+	assert.NoError(t, saveUploadSession(context.Background(), PackageBaseDirectory, org, *session))
+	code, _ = progress(s.Id)
+	assert.Equal(t, http.StatusNotFound, code)
+	removed, err := CleanUploads(context.Background(), PackageBaseDirectory)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.NoDirExists(t, filepath.Join(tmpDir, uploadsDir, org, s.Id))
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+const (
+	defaultSearchLimit = 100
+	maxSearchLimit     = 1000
+)
+
+// packageQuery - the filters for a package search, empty fields match everything
+type packageQuery struct {
+	name       string
+	provides   string
+	origin     string
+	maintainer string
+	versions   []versionConstraint
+}
+
+// stripVersion - turn a provides/depends entry like so:libfoo.so.1=1.2.3 into so:libfoo.so.1
+func stripVersion(entry string) string {
+	if i := strings.IndexAny(entry, "<>=~"); i >= 0 {
+		return entry[:i]
+	}
+	return entry
+}
+
+// globMatch - path.Match, but a broken pattern just doesn't match anything
+func globMatch(pattern, s string) bool {
+	ok, err := path.Match(pattern, s)
+	return err == nil && ok
+}
+
+func (q packageQuery) matches(pkg *repository.Package) bool {
+	if q.name != "" && !globMatch(q.name, pkg.Name) {
+		return false
+	}
+	if q.origin != "" && !globMatch(q.origin, pkg.Origin) {
+		return false
+	}
+	if q.maintainer != "" && !strings.Contains(strings.ToLower(pkg.Maintainer), strings.ToLower(q.maintainer)) {
+		return false
+	}
+	if q.provides != "" {
+		found := false
+		for _, p := range pkg.Provides {
+			if globMatch(q.provides, stripVersion(p)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return versionInRange(pkg.Version, q.versions)
+}
+
+// optionalString - nil for empty strings so they get left out of the json
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalStrings - nil for empty lists so they get left out of the json
+func optionalStrings(s []string) *[]string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
+
+// indexedPackageFrom - convert a package from a parsed APKINDEX into the API representation
+func indexedPackageFrom(loc RepoLocation, pkg *repository.Package) IndexedPackage {
+	filename := pkg.Filename()
+	checksum := pkg.ChecksumString()
+	size := int64(pkg.Size)
+	installedSize := int64(pkg.InstalledSize)
+	ip := IndexedPackage{
+		Name:          pkg.Name,
+		Version:       pkg.Version,
+		Distro:        loc.Distro,
+		DistroVersion: loc.Version,
+		Repo:          loc.Repo,
+		Arch:          loc.Arch,
+		Filename:      &filename,
+		Checksum:      &checksum,
+		Size:          &size,
+		InstalledSize: &installedSize,
+		Description:   optionalString(pkg.Description),
+		License:       optionalString(pkg.License),
+		Origin:        optionalString(pkg.Origin),
+		Maintainer:    optionalString(pkg.Maintainer),
+		Url:           optionalString(pkg.URL),
+		Depends:       optionalStrings(pkg.Dependencies),
+		Provides:      optionalStrings(pkg.Provides),
+		InstallIf:     optionalStrings(pkg.InstallIf),
+	}
+	if !pkg.BuildTime.IsZero() {
+		bt := pkg.BuildTime.UTC()
+		ip.BuildTime = &bt
+	}
+	return ip
+}
+
+// searchPackages - find every indexed package in the locations matching filter that satisfies q
+func searchPackages(basedir string, filter RepoLocation, q packageQuery) ([]IndexedPackage, error) {
+	result := []IndexedPackage{}
+	locs, err := FindRepoLocations(basedir, filter)
+	if err != nil {
+		return result, err
+	}
+	for _, loc := range locs {
+		pkgs, err := loadRepoIndex(basedir, loc)
+		if err != nil {
+			// one broken index shouldn't break searching everything else
+			log.Warn().Err(err).Interface("location", loc).Msg("searchPackages: failed to load index")
+			continue
+		}
+		for _, pkg := range pkgs {
+			if q.matches(pkg) {
+				result = append(result, indexedPackageFrom(loc, pkg))
+			}
+		}
+	}
+	return result, nil
+}
+
+// sortIndexedPackages - sort search results, ties are broken by name, version and then location
+// so the order (and therefore paging) is stable between requests
+func sortIndexedPackages(pkgs []IndexedPackage, field SearchPackagesParamsSort, desc bool) {
+	location := func(p IndexedPackage) string {
+		return strings.Join([]string{p.Distro, p.DistroVersion, p.Repo, p.Arch}, "/")
+	}
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		a, b := pkgs[i], pkgs[j]
+		var c int
+		switch field {
+		case SearchPackagesParamsSortVersion:
+			c = compareVersions(a.Version, b.Version)
+		case SearchPackagesParamsSortRepo:
+			c = strings.Compare(location(a), location(b))
+		case SearchPackagesParamsSortBuildTime:
+			switch {
+			case a.BuildTime == nil || b.BuildTime == nil:
+			case a.BuildTime.Before(*b.BuildTime):
+				c = -1
+			case a.BuildTime.After(*b.BuildTime):
+				c = 1
+			}
+		}
+		if c == 0 {
+			c = strings.Compare(a.Name, b.Name)
+		}
+		if c == 0 {
+			c = compareVersions(a.Version, b.Version)
+		}
+		if c == 0 {
+			c = strings.Compare(location(a), location(b))
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// SearchPackages - search the package indexes of an org
+func (p *PkgRepoAPI) SearchPackages(ctx echo.Context, org string, params SearchPackagesParams) error {
+	limit := defaultSearchLimit
+	if params.Limit != nil {
+		// don't quietly hand back fewer results than were asked for
+		if *params.Limit < 1 || *params.Limit > maxSearchLimit {
+			return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: fmt.Sprintf("invalid limit, it has to be between 1 and %d", maxSearchLimit)})
+		}
+		limit = *params.Limit
+	}
+	filter := RepoLocation{Org: org}
+	if params.Distro != nil {
+		filter.Distro = *params.Distro
+	}
+	if params.DistroVersion != nil {
+		filter.Version = *params.DistroVersion
+	}
+	if params.Repo != nil {
+		filter.Repo = *params.Repo
+	}
+	if params.Arch != nil {
+		filter.Arch = *params.Arch
+	}
+
+	var q packageQuery
+	if params.Name != nil {
+		q.name = *params.Name
+	}
+	if params.Provides != nil {
+		q.provides = *params.Provides
+	}
+	if params.Origin != nil {
+		q.origin = *params.Origin
+	}
+	if params.Maintainer != nil {
+		q.maintainer = *params.Maintainer
+	}
+	if params.VersionRange != nil {
+		constraints, err := parseVersionRange(*params.VersionRange)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid version range: " + err.Error()})
+		}
+		q.versions = constraints
+	}
+
+	pkgs, err := searchPackages(PackageBaseDirectory, filter, q)
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Msg("failed to search packages")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to search packages"})
+	}
+
+	field := SearchPackagesParamsSortName
+	if params.Sort != nil {
+		field = *params.Sort
+	}
+	sortIndexedPackages(pkgs, field, params.Order != nil && *params.Order == Desc)
+
+	offset := 0
+	if params.Offset != nil && *params.Offset > 0 {
+		offset = *params.Offset
+	}
+
+	results := SearchResults{Total: len(pkgs), Packages: []IndexedPackage{}}
+	if offset < len(pkgs) {
+		end := min(offset+limit, len(pkgs))
+		results.Packages = pkgs[offset:end]
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+// writeTestIndex - write an unsigned APKINDEX.tar.gz containing pkgs into the static dir for loc
+func writeTestIndex(t *testing.T, tmpDir string, loc RepoLocation, pkgs []*repository.Package) {
+	t.Helper()
+	archive, err := repository.ArchiveFromIndex(&repository.ApkIndex{Description: "test", Packages: pkgs})
+	if err != nil {
+		t.Fatal("failed to create test index", err)
+	}
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		t.Fatal("failed to read test index", err)
+	}
+	dir := filepath.Join(tmpDir, "static", loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test index path", err)
+	}
+	err = os.WriteFile(filepath.Join(dir, "APKINDEX.tar.gz"), data, 0644)
+	if err != nil {
+		t.Fatal("failed to write test index", err)
+	}
+}
+
+func TestSearchPackages(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-search-*")
+	if err != nil {
+		t.Fatal("failed to create testSearchPackages tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testSearchPackages tmpDir", err)
+		}
+	}()
+
+	mainRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main", Arch: "x86_64"}
+	testingRepo := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "testing", Arch: "x86_64"}
+	writeTestIndex(t, tmpDir, mainRepo, []*repository.Package{
+		{Name: "libfoo", Version: "1.2.0-r0", Origin: "foo", Maintainer: "Jane <jane@example.com>", Provides: []string{"so:libfoo.so.1=1.2.0"}},
+		{Name: "foo", Version: "1.2.0-r0", Origin: "foo", Provides: []string{"cmd:foo=1.2.0-r0"}},
+	})
+	writeTestIndex(t, tmpDir, testingRepo, []*repository.Package{
+		{Name: "py3-foo", Version: "2.0-r1", Origin: "py3-foo"},
+		{Name: "libfoo", Version: "2.0.0-r0", Origin: "foo", Provides: []string{"so:libfoo.so.2=2.0.0"}},
+	})
+
+	basedir := "file://" + tmpDir
+
+	pkgs, err := searchPackages(basedir, RepoLocation{Org: "testorg"}, packageQuery{name: "py3-*"})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+	assert.Equal(t, "testing", pkgs[0].Repo)
+
+	pkgs, err = searchPackages(basedir, RepoLocation{Org: "testorg"}, packageQuery{provides: "so:libfoo.so.1"})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+	assert.Equal(t, "1.2.0-r0", pkgs[0].Version)
+
+	pkgs, err = searchPackages(basedir, RepoLocation{Org: "testorg"}, packageQuery{origin: "foo", maintainer: "JANE"})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+
+	constraints, err := parseVersionRange(">=2")
+	assert.NoError(t, err)
+	pkgs, err = searchPackages(basedir, RepoLocation{Org: "testorg"}, packageQuery{name: "libfoo", versions: constraints})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+	assert.Equal(t, "testing", pkgs[0].Repo)
+
+	pkgs, err = searchPackages(basedir, RepoLocation{Org: "testorg", Repo: "main"}, packageQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 2)
+
+	pkgs, err = searchPackages(basedir, RepoLocation{Org: "testorg"}, packageQuery{})
+	assert.NoError(t, err)
+	sortIndexedPackages(pkgs, SearchPackagesParamsSortVersion, true)
+	assert.Equal(t, "2.0.0-r0", pkgs[0].Version)
+
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = basedir
+	defer func() { PackageBaseDirectory = originalDir }()
+	e := echo.New()
+	search := func(limit int) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		err := (&PkgRepoAPI{}).SearchPackages(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), "testorg", SearchPackagesParams{Limit: &limit})
+		assert.NoError(t, err)
+		return rec
+	}
+	rec := search(1)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var results SearchResults
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	assert.Equal(t, 4, results.Total)
+	assert.Len(t, results.Packages, 1)
+	// limits that can't be met are turned away rather than quietly changed
+	assert.Equal(t, http.StatusBadRequest, search(maxSearchLimit+1).Code)
+	assert.Equal(t, http.StatusBadRequest, search(0).Code)
+}
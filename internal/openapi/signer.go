@@ -0,0 +1,406 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/viant/afs"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// Signer - signs APKINDEX archives, apk checks the signature as RSA PKCS#1 v1.5 over the SHA1
+// digest of the archive with the public key in /etc/apk/keys that's named KeyName()
+// implementations don't need the private key in this process, so it can live outside the
+// package tree
+type Signer interface {
+	// KeyName - the public key's filename, e.g. someone@example.com-1234.rsa.pub
+	KeyName() string
+	// Sign - sign an archive, returning the raw signature
+	Sign(archive []byte) ([]byte, error)
+}
+
+// keySigner - signs with a private key that's already been loaded
+type keySigner struct {
+	keyName string
+	key     *rsa.PrivateKey
+}
+
+// NewKeySigner - a Signer for a private key in memory
+func NewKeySigner(key *rsa.PrivateKey, keyName string) Signer {
+	return keySigner{keyName: keyName, key: key}
+}
+
+func (s keySigner) KeyName() string {
+	return s.keyName
+}
+
+func (s keySigner) Sign(archive []byte) ([]byte, error) {
+	digest := sha1.Sum(archive)
+	return rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA1, digest[:])
+}
+
+// commandSigner - pipes the archive through an external command that writes the raw signature to
+// stdout, e.g. openssl dgst -sha1 -sign /path/to/key.rsa
+type commandSigner struct {
+	keyName string
+	public  *rsa.PublicKey
+	command []string
+}
+
+// NewCommandSigner - a Signer that runs command for every signature, if public is set the
+// signature is checked against it, so a misconfigured command can't publish a broken index
+func NewCommandSigner(keyName string, public *rsa.PublicKey, command []string) Signer {
+	return commandSigner{keyName: keyName, public: public, command: command}
+}
+
+func (s commandSigner) KeyName() string {
+	return s.keyName
+}
+
+func (s commandSigner) Sign(archive []byte) ([]byte, error) {
+	if len(s.command) == 0 {
+		return nil, errors.New("no signing command configured")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(s.command[0], s.command[1:]...)
+	cmd.Stdin = bytes.NewReader(archive)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("signing command %s failed: %w: %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	if stdout.Len() == 0 {
+		return nil, fmt.Errorf("signing command %s didn't output a signature", s.command[0])
+	}
+	sig := stdout.Bytes()
+	if s.public != nil {
+		digest := sha1.Sum(archive)
+		err = rsa.VerifyPKCS1v15(s.public, crypto.SHA1, digest[:], sig)
+		if err != nil {
+			return nil, fmt.Errorf("signature from %s doesn't match %s: %w", s.command[0], s.keyName, err)
+		}
+	}
+	return sig, nil
+}
+
+// agentSigner - asks an ssh-agent (or anything else speaking its protocol) listening on a local
+// socket to sign, an ssh-rsa signature is the PKCS#1 v1.5 SHA1 signature apk wants
+type agentSigner struct {
+	keyName string
+	socket  string
+	public  *rsa.PublicKey
+}
+
+// NewAgentSigner - a Signer for the agent key matching public
+func NewAgentSigner(keyName, socket string, public *rsa.PublicKey) Signer {
+	return agentSigner{keyName: keyName, socket: socket, public: public}
+}
+
+func (s agentSigner) KeyName() string {
+	return s.keyName
+}
+
+func (s agentSigner) Sign(archive []byte) ([]byte, error) {
+	pub, err := ssh.NewPublicKey(s.public)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.Dial("unix", s.socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to signing agent: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	// no flags asks for the original ssh-rsa (SHA1) signature rather than rsa-sha2-*
+	sig, err := agent.NewClient(conn).SignWithFlags(pub, archive, 0)
+	if err != nil {
+		return nil, fmt.Errorf("signing agent failed to sign with %s: %w", s.keyName, err)
+	}
+	if sig.Format != ssh.KeyAlgoRSA {
+		return nil, fmt.Errorf("signing agent returned a %s signature instead of %s", sig.Format, ssh.KeyAlgoRSA)
+	}
+	return sig.Blob, nil
+}
+
+// signatureBlock - the gzip'd tar entry apk expects in front of a signed archive, the end of
+// archive marker is left off so it runs straight into the archive itself
+func signatureBlock(keyName string, sig []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	err := tw.WriteHeader(&tar.Header{Name: ".SIGN.RSA." + keyName, Mode: 0644, Size: int64(len(sig)), Uname: "root", Gname: "root"})
+	if err != nil {
+		return nil, err
+	}
+	_, err = tw.Write(sig)
+	if err != nil {
+		return nil, err
+	}
+	// Flush, not Close, Close would write the end of archive marker
+	err = tw.Flush()
+	if err != nil {
+		return nil, err
+	}
+	err = gz.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// signArchive - sign an index archive with every signer
+// the signatures are stacked in front of the archive and apk uses the first one it has a key for
+func signArchive(archive []byte, signers []Signer) ([]byte, error) {
+	var signed bytes.Buffer
+	for _, s := range signers {
+		sig, err := s.Sign(archive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign with %s: %w", s.KeyName(), err)
+		}
+		block, err := signatureBlock(s.KeyName(), sig)
+		if err != nil {
+			return nil, err
+		}
+		signed.Write(block)
+	}
+	signed.Write(archive)
+	return signed.Bytes(), nil
+}
+
+// KeyPassphrase - read a private key's passphrase from an environment variable or a file,
+// whichever is set, trailing newlines are dropped since that's how most secrets end up on disk
+func KeyPassphrase(env, path string) ([]byte, error) {
+	switch {
+	case env != "":
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return nil, fmt.Errorf("passphrase environment variable %s isn't set", env)
+		}
+		return []byte(v), nil
+	case path != "":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		return bytes.TrimRight(data, "\r\n"), nil
+	}
+	return nil, nil
+}
+
+// LoadKeySigner - a Signer for the PEM private key at keyPath (a path or URL), signing as
+// <basename of keyPath>.pub
+func LoadKeySigner(keyPath string, passphrase []byte) (Signer, error) {
+	ctx := context.Background()
+	keyURI := keyPath
+	if !strings.Contains(keyURI, "://") {
+		keyURI = "file://" + keyURI
+	}
+	data, err := afs.New().DownloadWithURL(ctx, keyURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rsa key: %w", err)
+	}
+	key, err := parsePrivateKey(data, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySigner(key, path.Base(keyPath)+".pub"), nil
+}
+
+// LoadPublicKey - load a PEM public key like the ones in /etc/apk/keys
+func LoadPublicKey(keyPath string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+	return parsePublicKey(data)
+}
+
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode PEM")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		// some older keys are bare PKCS1
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key: %w", err)
+		}
+		return key, nil
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA key")
+	}
+	return key, nil
+}
+
+// parsePrivateKey - parse a PEM encoded RSA key, openssl genrsa has written both PKCS1 and PKCS8
+// depending on the version, and either can be encrypted
+func parsePrivateKey(data, passphrase []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode PEM")
+	}
+	der := block.Bytes
+	switch {
+	case block.Type == "ENCRYPTED PRIVATE KEY":
+		if passphrase == nil {
+			return nil, errors.New("key is encrypted and there's no passphrase")
+		}
+		var err error
+		der, err = decryptPKCS8(block.Bytes, passphrase)
+		if err != nil {
+			return nil, err
+		}
+	case x509.IsEncryptedPEMBlock(block): //nolint:staticcheck // old openssl "Proc-Type: 4,ENCRYPTED" keys are still around
+		if passphrase == nil {
+			return nil, errors.New("key is encrypted and there's no passphrase")
+		}
+		var err error
+		der, err = x509.DecryptPEMBlock(block, passphrase) //nolint:staticcheck // see above
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt key: %w", err)
+		}
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(der)
+	if err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key from der: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA key")
+	}
+	return key, nil
+}
+
+// the parts of PKCS#5 v2 (RFC 8018) that openssl uses for encrypted PKCS#8 keys by default, the
+// standard library can parse PKCS#8 but not decrypt it
+var (
+	oidPBES2      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// decryptPKCS8 - decrypt a PBES2/PBKDF2/AES-CBC encrypted PKCS#8 key
+func decryptPKCS8(data, passphrase []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	_, err := asn1.Unmarshal(data, &info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse encrypted key: %w", err)
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported key encryption %s", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	_, err = asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key encryption parameters: %w", err)
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation %s", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	_, err = asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key derivation parameters: %w", err)
+	}
+
+	var prf func() hash.Hash
+	switch {
+	case len(kdf.PRF.Algorithm) == 0, kdf.PRF.Algorithm.Equal(oidHMACSHA1):
+		prf = sha1.New
+	case kdf.PRF.Algorithm.Equal(oidHMACSHA256):
+		prf = sha256.New
+	default:
+		return nil, fmt.Errorf("unsupported key derivation hash %s", kdf.PRF.Algorithm)
+	}
+	var keyLen int
+	switch {
+	case params.EncryptionScheme.Algorithm.Equal(oidAES128CBC):
+		keyLen = 16
+	case params.EncryptionScheme.Algorithm.Equal(oidAES192CBC):
+		keyLen = 24
+	case params.EncryptionScheme.Algorithm.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("unsupported key cipher %s", params.EncryptionScheme.Algorithm)
+	}
+	var iv []byte
+	_, err = asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("failed to parse key cipher parameters")
+	}
+
+	key, err := pbkdf2.Key(prf, string(passphrase), kdf.Salt, kdf.IterationCount, keyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, errors.New("encrypted key has the wrong length")
+	}
+	plain := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, info.EncryptedData)
+
+	// PKCS#7 padding, a wrong passphrase almost always shows up here
+	pad := int(plain[len(plain)-1])
+	if pad == 0 || pad > aes.BlockSize || !bytes.Equal(plain[len(plain)-pad:], bytes.Repeat([]byte{byte(pad)}, pad)) {
+		return nil, errors.New("failed to decrypt key, wrong passphrase?")
+	}
+	return plain[:len(plain)-pad], nil
+}
@@ -0,0 +1,461 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+	"gopkg.in/yaml.v3"
+)
+
+// a snapshot pins a repo as it was at some point, it's kept under
+// snapshots/<org>/<distro>/<version>/<repo>/<name>/ as snapshot.yaml (the package list) and a copy
+// of each arch's APKINDEX.tar.gz, exactly as it was signed
+// the packages themselves go in .pool/ next to the snapshots, named by their sha256, so a package
+// that's in many snapshots is only stored once. on a local filesystem they're hard links to the
+// repo's files, so they don't take up any space until the repo's copy is deleted
+
+const (
+	snapshotManifestFile = "snapshot.yaml"
+	snapshotPoolDir      = ".pool"
+)
+
+var (
+	snapshotNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+	errSnapshotExists   = errors.New("snapshot already exists")
+	errSnapshotNotFound = errors.New("no such snapshot")
+	errSnapshotName     = errors.New("snapshot names can only have letters, numbers, '.', '_' and '-' in them")
+	errNoIndex          = errors.New("repo doesn't have an index")
+)
+
+// snapshotsMu - snapshots are created and deleted one at a time, so deleting one can't clean up
+// pool files a new one is about to use
+var snapshotsMu sync.Mutex
+
+type snapshotPackage struct {
+	File   string `yaml:"file"`
+	SHA256 string `yaml:"sha256"`
+	Size   int64  `yaml:"size"`
+}
+
+type snapshotArch struct {
+	Arch     string            `yaml:"arch"`
+	Packages []snapshotPackage `yaml:"packages"`
+}
+
+// snapshotManifest - the contents of snapshot.yaml
+type snapshotManifest struct {
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description,omitempty"`
+	Created     time.Time      `yaml:"created"`
+	Arches      []snapshotArch `yaml:"arches"`
+}
+
+// snapshotsURI - where a repo's snapshots are, loc.Arch is ignored
+func snapshotsURI(basedir string, loc RepoLocation) string {
+	return url.JoinUNC(basedir, "snapshots", loc.Org, loc.Distro, loc.Version, loc.Repo)
+}
+
+func snapshotPoolURI(basedir string, loc RepoLocation, sum string) string {
+	return url.JoinUNC(snapshotsURI(basedir, loc), snapshotPoolDir, sum+".apk")
+}
+
+// linkOrCopy - hard link src to dst if they're both local files, copy it otherwise
+func linkOrCopy(ctx context.Context, cfs afs.Service, src, dst string) error {
+	err := hardLink(src, dst)
+	if err == nil {
+		return nil
+	}
+	if url.Scheme(src, file.Scheme) == file.Scheme && url.Scheme(dst, file.Scheme) == file.Scheme {
+		log.Debug().Err(err).Str("src", src).Msg("failed to hard link, copying instead")
+	}
+	return cfs.Copy(ctx, src, dst)
+}
+
+// addToPool - put a package in a repo's snapshot pool, returning its sha256 and size
+// it's linked (or copied) in under a temporary name and hashed there, so it can't change between
+// being hashed and ending up in the pool
+func addToPool(ctx context.Context, cfs afs.Service, basedir string, loc RepoLocation, src string) (string, int64, error) {
+	var rnd [8]byte
+	_, _ = rand.Read(rnd[:])
+	tmp := url.JoinUNC(snapshotsURI(basedir, loc), snapshotPoolDir, "tmp-"+hex.EncodeToString(rnd[:]))
+	err := linkOrCopy(ctx, cfs, src, tmp)
+	if err != nil {
+		return "", 0, err
+	}
+	rdr, err := cfs.OpenURL(ctx, tmp)
+	if err != nil {
+		_ = cfs.Delete(ctx, tmp)
+		return "", 0, err
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, rdr)
+	_ = rdr.Close()
+	if err != nil {
+		_ = cfs.Delete(ctx, tmp)
+		return "", 0, err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	dst := snapshotPoolURI(basedir, loc, sum)
+	ex, err := cfs.Exists(ctx, dst)
+	if err != nil || ex {
+		_ = cfs.Delete(ctx, tmp)
+		return sum, size, err
+	}
+	return sum, size, cfs.Move(ctx, tmp, dst)
+}
+
+// loadSnapshot - read a snapshot's manifest
+func loadSnapshot(basedir string, loc RepoLocation, name string) (*snapshotManifest, error) {
+	if !snapshotNamePattern.MatchString(name) {
+		return nil, errSnapshotNotFound
+	}
+	ctx := context.Background()
+	cfs := afs.New()
+	uri := url.JoinUNC(snapshotsURI(basedir, loc), name, snapshotManifestFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if !ex {
+		return nil, errSnapshotNotFound
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var m snapshotManifest
+	err = yaml.Unmarshal(data, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	return &m, nil
+}
+
+// listSnapshots - every snapshot of a repo, oldest first
+func listSnapshots(basedir string, loc RepoLocation) ([]snapshotManifest, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	dir := url.Normalize(snapshotsURI(basedir, loc), file.Scheme)
+	ex, err := cfs.Exists(ctx, dir)
+	if err != nil || !ex {
+		return nil, err
+	}
+	list, err := cfs.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []snapshotManifest
+	for _, f := range list {
+		if !f.IsDir() || f.URL() == dir || f.Name() == snapshotPoolDir {
+			continue
+		}
+		m, err := loadSnapshot(basedir, loc, f.Name())
+		if errors.Is(err, errSnapshotNotFound) {
+			// one that's still being created, or failed part way through
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, *m)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Created.Before(snapshots[j].Created) })
+	return snapshots, nil
+}
+
+// createSnapshot - snapshot the index and packages of a repo's arches, all of them if arches is
+// empty
+func createSnapshot(ctx context.Context, basedir string, loc RepoLocation, name, description string, arches []string) (*snapshotManifest, error) {
+	if !snapshotNamePattern.MatchString(name) || len(name) > 128 {
+		return nil, errSnapshotName
+	}
+	loc.Arch = ""
+	snapshotsMu.Lock()
+	defer snapshotsMu.Unlock()
+
+	cfs := afs.New()
+	dir := url.JoinUNC(snapshotsURI(basedir, loc), name)
+	ex, err := cfs.Exists(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	if ex {
+		return nil, errSnapshotExists
+	}
+	if len(arches) == 0 {
+		locs, err := FindRepoLocations(basedir, loc)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range locs {
+			arches = append(arches, l.Arch)
+		}
+	}
+	if len(arches) == 0 {
+		return nil, errNoIndex
+	}
+
+	m := &snapshotManifest{Name: name, Description: description, Created: time.Now().UTC()}
+	err = func() error {
+		for _, arch := range arches {
+			archLoc := loc
+			archLoc.Arch = arch
+			sa, err := snapshotRepoArch(ctx, cfs, basedir, archLoc, dir)
+			if err != nil {
+				return fmt.Errorf("%s: %w", arch, err)
+			}
+			m.Arches = append(m.Arches, sa)
+		}
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			return err
+		}
+		// the manifest goes in last, a snapshot without one doesn't exist
+		return cfs.Upload(ctx, url.JoinUNC(dir, snapshotManifestFile), 0644, bytes.NewReader(data))
+	}()
+	if err != nil {
+		_ = cfs.Delete(ctx, dir)
+		cleanSnapshotPool(ctx, basedir, loc)
+		return nil, err
+	}
+	log.Info().Str("org", loc.Org).Str("distro", loc.Distro).Str("version", loc.Version).Str("repo", loc.Repo).Str("snapshot", name).Msg("created snapshot")
+	return m, nil
+}
+
+// snapshotRepoArch - copy an arch's index into a snapshot and its packages into the pool
+func snapshotRepoArch(ctx context.Context, cfs afs.Service, basedir string, loc RepoLocation, dir string) (snapshotArch, error) {
+	sa := snapshotArch{Arch: loc.Arch, Packages: []snapshotPackage{}}
+	// repoFile makes sure proxies and virtual repos have an up to date index too
+	indexURI, err := repoFile(ctx, basedir, loc, "APKINDEX.tar.gz")
+	if errors.Is(err, errNoRepoFile) {
+		return sa, errNoIndex
+	}
+	if err != nil {
+		return sa, err
+	}
+	index, err := cfs.DownloadWithURL(ctx, indexURI)
+	if err != nil {
+		return sa, err
+	}
+	apki, err := repository.IndexFromArchive(io.NopCloser(bytes.NewReader(index)))
+	if err != nil {
+		return sa, fmt.Errorf("failed to parse index: %w", err)
+	}
+	for _, p := range apki.Packages {
+		// and fetches anything a proxy doesn't have yet
+		src, err := repoFile(ctx, basedir, loc, p.Filename())
+		if err != nil {
+			return sa, fmt.Errorf("%s: %w", p.Filename(), err)
+		}
+		sum, size, err := addToPool(ctx, cfs, basedir, loc, src)
+		if err != nil {
+			return sa, fmt.Errorf("failed to add %s to the snapshot pool: %w", p.Filename(), err)
+		}
+		sa.Packages = append(sa.Packages, snapshotPackage{File: p.Filename(), SHA256: sum, Size: size})
+	}
+	err = cfs.Upload(ctx, url.JoinUNC(dir, loc.Arch, "APKINDEX.tar.gz"), 0644, bytes.NewReader(index))
+	return sa, err
+}
+
+// deleteSnapshot - delete a snapshot, and any pool files that were only in it
+func deleteSnapshot(ctx context.Context, basedir string, loc RepoLocation, name string) error {
+	loc.Arch = ""
+	snapshotsMu.Lock()
+	defer snapshotsMu.Unlock()
+	_, err := loadSnapshot(basedir, loc, name)
+	if err != nil {
+		return err
+	}
+	cfs := afs.New()
+	err = cfs.Delete(ctx, url.JoinUNC(snapshotsURI(basedir, loc), name))
+	if err != nil {
+		return err
+	}
+	cleanSnapshotPool(ctx, basedir, loc)
+	log.Info().Str("org", loc.Org).Str("distro", loc.Distro).Str("version", loc.Version).Str("repo", loc.Repo).Str("snapshot", name).Msg("deleted snapshot")
+	return nil
+}
+
+// cleanSnapshotPool - remove pool files that no snapshot has in it any more, snapshotsMu has to
+// be held. errors are only logged, the worst that happens is some space is wasted until next time
+func cleanSnapshotPool(ctx context.Context, basedir string, loc RepoLocation) {
+	snapshots, err := listSnapshots(basedir, loc)
+	if err != nil {
+		log.Error().Err(err).Str("repo", loc.Repo).Msg("failed to list snapshots to clean up the pool")
+		return
+	}
+	keep := map[string]bool{}
+	for _, s := range snapshots {
+		for _, a := range s.Arches {
+			for _, p := range a.Packages {
+				keep[p.SHA256+".apk"] = true
+			}
+		}
+	}
+	cfs := afs.New()
+	pool := url.Normalize(url.JoinUNC(snapshotsURI(basedir, loc), snapshotPoolDir), file.Scheme)
+	ex, err := cfs.Exists(ctx, pool)
+	if err != nil || !ex {
+		return
+	}
+	list, err := cfs.List(ctx, pool)
+	if err != nil {
+		log.Error().Err(err).Str("uri", pool).Msg("failed to list the snapshot pool")
+		return
+	}
+	for _, f := range list {
+		if f.IsDir() || keep[f.Name()] {
+			continue
+		}
+		err := cfs.Delete(ctx, url.JoinUNC(pool, f.Name()))
+		if err != nil {
+			log.Error().Err(err).Str("uri", pool).Str("file", f.Name()).Msg("failed to delete unused snapshot pool file")
+		}
+	}
+}
+
+// snapshotFile - where a file in a snapshot is stored
+func snapshotFile(basedir string, loc RepoLocation, name, arch, fileName string) (string, error) {
+	loc.Arch = ""
+	m, err := loadSnapshot(basedir, loc, name)
+	if err != nil {
+		return "", err
+	}
+	i := slices.IndexFunc(m.Arches, func(a snapshotArch) bool { return a.Arch == arch })
+	if i < 0 {
+		return "", errNoRepoFile
+	}
+	if fileName == "APKINDEX.tar.gz" {
+		return url.JoinUNC(snapshotsURI(basedir, loc), name, arch, fileName), nil
+	}
+	for _, p := range m.Arches[i].Packages {
+		if p.File == fileName {
+			return snapshotPoolURI(basedir, loc, p.SHA256), nil
+		}
+	}
+	return "", errNoRepoFile
+}
+
+// snapshotFrom - convert a manifest into the API representation
+func snapshotFrom(m snapshotManifest, withPackages bool) Snapshot {
+	s := Snapshot{Name: m.Name, Created: m.Created, Arches: []SnapshotArch{}}
+	if m.Description != "" {
+		s.Description = &m.Description
+	}
+	for _, a := range m.Arches {
+		sa := SnapshotArch{Arch: a.Arch, PackageCount: len(a.Packages)}
+		if withPackages {
+			pkgs := []SnapshotPackage{}
+			for _, p := range a.Packages {
+				pkgs = append(pkgs, SnapshotPackage{File: p.File, Sha256: p.SHA256, Size: p.Size})
+			}
+			sa.Packages = &pkgs
+		}
+		s.Arches = append(s.Arches, sa)
+	}
+	return s
+}
+
+// ListSnapshots - list a repo's snapshots
+func (p *PkgRepoAPI) ListSnapshots(ctx echo.Context, org, distro, version, repo string) error {
+	snapshots, err := listSnapshots(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo})
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Msg("failed to list snapshots")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to list snapshots"})
+	}
+	result := []Snapshot{}
+	for _, s := range snapshots {
+		result = append(result, snapshotFrom(s, false))
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateSnapshot - snapshot a repo as it is now
+func (p *PkgRepoAPI) CreateSnapshot(ctx echo.Context, org, distro, version, repo string) error {
+	var req NewSnapshot
+	err := ctx.Bind(&req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid request"})
+	}
+	var description string
+	if req.Description != nil {
+		description = *req.Description
+	}
+	var arches []string
+	if req.Arches != nil {
+		arches = *req.Arches
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	m, err := createSnapshot(ctx.Request().Context(), PackageBaseDirectory, loc, req.Name, description, arches)
+	switch {
+	case errors.Is(err, errSnapshotExists):
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	case errors.Is(err, errSnapshotName), errors.Is(err, errNoIndex):
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: err.Error()})
+	case err != nil:
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("snapshot", req.Name).Msg("failed to create snapshot")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to create snapshot: " + err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, snapshotFrom(*m, true))
+}
+
+// GetSnapshot - return a snapshot and its packages
+func (p *PkgRepoAPI) GetSnapshot(ctx echo.Context, org, distro, version, repo, snapshot string) error {
+	m, err := loadSnapshot(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}, snapshot)
+	if errors.Is(err, errSnapshotNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	}
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("snapshot", snapshot).Msg("failed to load snapshot")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to load snapshot"})
+	}
+	return ctx.JSON(http.StatusOK, snapshotFrom(*m, true))
+}
+
+// DeleteSnapshot - delete a snapshot
+func (p *PkgRepoAPI) DeleteSnapshot(ctx echo.Context, org, distro, version, repo, snapshot string) error {
+	err := deleteSnapshot(ctx.Request().Context(), PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}, snapshot)
+	if errors.Is(err, errSnapshotNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	}
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("snapshot", snapshot).Msg("failed to delete snapshot")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to delete snapshot"})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetSnapshotFile - download a package or index from a snapshot
+func (p *PkgRepoAPI) GetSnapshotFile(ctx echo.Context, org, distro, version, repo, snapshot, arch, name string) error {
+	if !validPathSegments(org, distro, version, repo, arch, name) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: "no such file"})
+	}
+	uri, err := snapshotFile(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}, snapshot, arch, name)
+	if errors.Is(err, errSnapshotNotFound) || errors.Is(err, errNoRepoFile) {
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	}
+	if err != nil {
+		log.Error().Err(err).Str("org", org).Str("repo", repo).Str("snapshot", snapshot).Msg("failed to load snapshot")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "failed to get file"})
+	}
+	return streamFile(ctx, uri)
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshots(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-snapshot-*")
+	if err != nil {
+		t.Fatal("failed to create testSnapshots tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testSnapshots tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+	ctx := context.Background()
+
+	_, err = createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	staticDir := filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main", "x86_64")
+	err = os.MkdirAll(staticDir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test repo dir", err)
+	}
+	writeApk := func(name, version string) {
+		err := os.WriteFile(filepath.Join(staticDir, name+"-"+version+".apk"), buildTestApk(t, name, version, "x86_64"), 0644)
+		if err != nil {
+			t.Fatal("failed to write test package", err)
+		}
+	}
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "main"}
+
+	// no index yet, so nothing to snapshot
+	_, err = createSnapshot(ctx, basedir, loc, "empty", "", nil)
+	assert.True(t, errors.Is(err, errNoIndex))
+
+	writeApk("foo", "1.0-r0")
+	writeApk("bar", "1.0-r0")
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+	index, err := os.ReadFile(filepath.Join(staticDir, "APKINDEX.tar.gz"))
+	assert.NoError(t, err)
+	foo, err := os.ReadFile(filepath.Join(staticDir, "foo-1.0-r0.apk"))
+	assert.NoError(t, err)
+
+	_, err = createSnapshot(ctx, basedir, loc, "../escape", "", nil)
+	assert.True(t, errors.Is(err, errSnapshotName))
+
+	m, err := createSnapshot(ctx, basedir, loc, "2026-10", "october release", nil)
+	assert.NoError(t, err)
+	assert.Len(t, m.Arches, 1)
+	assert.Equal(t, "x86_64", m.Arches[0].Arch)
+	assert.Len(t, m.Arches[0].Packages, 2)
+	_, err = createSnapshot(ctx, basedir, loc, "2026-10", "", nil)
+	assert.True(t, errors.Is(err, errSnapshotExists))
+
+	// the live repo moves on, the snapshot doesn't
+	assert.NoError(t, os.Remove(filepath.Join(staticDir, "foo-1.0-r0.apk")))
+	writeApk("foo", "1.1-r0")
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+
+	uri, err := snapshotFile(basedir, loc, "2026-10", "x86_64", "APKINDEX.tar.gz")
+	assert.NoError(t, err)
+	data, err := os.ReadFile(uri[len("file://"):])
+	assert.NoError(t, err)
+	assert.Equal(t, index, data)
+	uri, err = snapshotFile(basedir, loc, "2026-10", "x86_64", "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+	data, err = os.ReadFile(uri[len("file://"):])
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	_, err = snapshotFile(basedir, loc, "2026-10", "x86_64", "foo-1.1-r0.apk")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+	_, err = snapshotFile(basedir, loc, "nope", "x86_64", "APKINDEX.tar.gz")
+	assert.True(t, errors.Is(err, errSnapshotNotFound))
+
+	// bar didn't change, so a second snapshot shares its copy
+	_, err = createSnapshot(ctx, basedir, loc, "2026-11", "", []string{"x86_64"})
+	assert.NoError(t, err)
+	pool, err := os.ReadDir(filepath.Join(tmpDir, "snapshots", "testorg", "alpine", "edge", "main", snapshotPoolDir))
+	assert.NoError(t, err)
+	assert.Len(t, pool, 3)
+
+	snapshots, err := listSnapshots(basedir, loc)
+	assert.NoError(t, err)
+	if assert.Len(t, snapshots, 2) {
+		assert.Equal(t, "2026-10", snapshots[0].Name)
+		assert.Equal(t, "2026-11", snapshots[1].Name)
+	}
+
+	// deleting one only cleans up what isn't in the other
+	err = deleteSnapshot(ctx, basedir, loc, "2026-10")
+	assert.NoError(t, err)
+	pool, err = os.ReadDir(filepath.Join(tmpDir, "snapshots", "testorg", "alpine", "edge", "main", snapshotPoolDir))
+	assert.NoError(t, err)
+	assert.Len(t, pool, 2)
+	err = deleteSnapshot(ctx, basedir, loc, "2026-10")
+	assert.True(t, errors.Is(err, errSnapshotNotFound))
+	uri, err = snapshotFile(basedir, loc, "2026-11", "x86_64", "bar-1.0-r0.apk")
+	assert.NoError(t, err)
+	assert.FileExists(t, uri[len("file://"):])
+}
@@ -0,0 +1,368 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/url"
+	"gopkg.in/yaml.v3"
+)
+
+// a transaction collects packages for a repo in uploads/<org>/<id>/<arch>/, next to the resumable
+// upload sessions, with transaction.yaml saying what's in it. nothing's visible until it's
+// committed: the packages are moved into their arches while holding those arches' index locks,
+// so no index is generated with only some of them, and then each arch's index is regenerated once
+
+const transactionFile = "transaction.yaml"
+
+var (
+	errTransactionNotFound   = errors.New("no such transaction")
+	errTransactionCommitting = errors.New("the transaction is being committed")
+)
+
+// uploadTransaction - the contents of transaction.yaml
+type uploadTransaction struct {
+	ID      string             `yaml:"id"`
+	Distro  string             `yaml:"distro"`
+	Version string             `yaml:"version"`
+	Repo    string             `yaml:"repo"`
+	Files   []transactionEntry `yaml:"files"`
+	// Committing - the commit has started, nothing can be added or aborted any more
+	Committing bool      `yaml:"committing,omitempty"`
+	Expires    time.Time `yaml:"expires"`
+}
+
+// transactionEntry - a package uploaded into a transaction
+type transactionEntry struct {
+	Arch    string `yaml:"arch"`
+	File    string `yaml:"file"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Size    int64  `yaml:"size"`
+	SHA256  string `yaml:"sha256"`
+}
+
+// matches - whether the transaction is for loc's repo, the id alone doesn't say
+func (t uploadTransaction) matches(loc RepoLocation) bool {
+	return t.Distro == loc.Distro && t.Version == loc.Version && t.Repo == loc.Repo
+}
+
+// arches - the arches the transaction has packages for, sorted
+func (t uploadTransaction) arches() []string {
+	seen := map[string]bool{}
+	var arches []string
+	for _, f := range t.Files {
+		if !seen[f.Arch] {
+			seen[f.Arch] = true
+			arches = append(arches, f.Arch)
+		}
+	}
+	sort.Strings(arches)
+	return arches
+}
+
+func transactionURI(basedir, org, id string) string {
+	return url.JoinUNC(basedir, uploadsDir, org, id)
+}
+
+func transactionFileURI(basedir, org, id, arch, name string) string {
+	return url.JoinUNC(transactionURI(basedir, org, id), arch, name)
+}
+
+// loadTransaction - read a transaction, ones that expired or are for a different repo don't exist
+func loadTransaction(ctx context.Context, basedir string, loc RepoLocation, id string) (*uploadTransaction, error) {
+	if !uploadSessionIDPattern.MatchString(id) {
+		return nil, errTransactionNotFound
+	}
+	cfs := afs.New()
+	uri := url.JoinUNC(transactionURI(basedir, loc.Org, id), transactionFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if !ex {
+		return nil, errTransactionNotFound
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var t uploadTransaction
+	err = yaml.Unmarshal(data, &t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if !t.matches(loc) || time.Now().After(t.Expires) {
+		return nil, errTransactionNotFound
+	}
+	return &t, nil
+}
+
+// saveTransaction - write a transaction's transaction.yaml
+func saveTransaction(ctx context.Context, basedir, org string, t uploadTransaction) error {
+	cfs := afs.New()
+	data, err := yaml.Marshal(t)
+	if err != nil {
+		return err
+	}
+	uri := url.JoinUNC(transactionURI(basedir, org, t.ID), transactionFile)
+	_ = cfs.Delete(ctx, uri)
+	return cfs.Upload(ctx, uri, 0644, bytes.NewReader(data))
+}
+
+// createTransaction - open a transaction for loc's repo
+func createTransaction(ctx context.Context, basedir string, loc RepoLocation) (*uploadTransaction, error) {
+	var rnd [16]byte
+	_, _ = rand.Read(rnd[:])
+	t := uploadTransaction{
+		ID:      hex.EncodeToString(rnd[:]),
+		Distro:  loc.Distro,
+		Version: loc.Version,
+		Repo:    loc.Repo,
+		Files:   []transactionEntry{},
+		Expires: time.Now().UTC().Add(uploadSessionTTL),
+	}
+	err := saveTransaction(ctx, basedir, loc.Org, t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// addTransactionFile - move a staged upload into a transaction, replacing the same arch and file if
+// it's already there. the staged file is gone afterwards either way
+func addTransactionFile(ctx context.Context, basedir string, loc RepoLocation, id string, up receivedUpload) (*uploadTransaction, error) {
+	cfs := afs.New()
+	uploadSessionsLock.Lock()
+	defer uploadSessionsLock.Unlock()
+	t, err := loadTransaction(ctx, basedir, loc, id)
+	if err == nil && t.Committing {
+		err = errTransactionCommitting
+	}
+	if err != nil {
+		_ = cfs.Delete(ctx, up.staged.uri)
+		return t, err
+	}
+	uri := transactionFileURI(basedir, loc.Org, id, loc.Arch, up.name)
+	_ = cfs.Delete(ctx, uri)
+	err = cfs.Move(ctx, up.staged.uri, uri)
+	if err != nil {
+		_ = cfs.Delete(ctx, up.staged.uri)
+		return t, err
+	}
+	entry := transactionEntry{Arch: loc.Arch, File: up.name, Name: up.pkg.Name, Version: up.pkg.Version, Size: up.staged.size, SHA256: up.staged.sha256}
+	files := []transactionEntry{entry}
+	for _, f := range t.Files {
+		if f.Arch != entry.Arch || f.File != entry.File {
+			files = append(files, f)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Arch != files[j].Arch {
+			return files[i].Arch < files[j].Arch
+		}
+		return files[i].File < files[j].File
+	})
+	t.Files = files
+	t.Expires = time.Now().UTC().Add(uploadSessionTTL)
+	return t, saveTransaction(ctx, basedir, loc.Org, *t)
+}
+
+// abortTransaction - throw a transaction away, along with everything uploaded into it
+func abortTransaction(ctx context.Context, basedir string, loc RepoLocation, id string) error {
+	uploadSessionsLock.Lock()
+	defer uploadSessionsLock.Unlock()
+	t, err := loadTransaction(ctx, basedir, loc, id)
+	if err != nil {
+		return err
+	}
+	if t.Committing {
+		return errTransactionCommitting
+	}
+	return afs.New().Delete(ctx, transactionURI(basedir, loc.Org, id))
+}
+
+// commitTransaction - publish a transaction's packages and regenerate the indexes of the arches they
+// went to, returning the files that were published. once the packages start moving it carries on
+// whatever the client does
+func commitTransaction(ctx context.Context, basedir string, loc RepoLocation, id string) (*uploadTransaction, []transactionEntry, error) {
+	uploadSessionsLock.Lock()
+	t, err := loadTransaction(ctx, basedir, loc, id)
+	if err == nil && t.Committing {
+		err = errTransactionCommitting
+	}
+	if err == nil {
+		t.Committing = true
+		t.Expires = time.Now().UTC().Add(uploadSessionTTL)
+		err = saveTransaction(ctx, basedir, loc.Org, *t)
+	}
+	uploadSessionsLock.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	arches := t.arches()
+	// always in the same order, and index generation only ever holds one, so they can't deadlock
+	var locks []func()
+	for _, arch := range arches {
+		archLoc := loc
+		archLoc.Arch = arch
+		l := indexLock(basedir, archLoc)
+		l.Lock()
+		locks = append(locks, l.Unlock)
+	}
+	var published []transactionEntry
+	for _, f := range t.Files {
+		archLoc := loc
+		archLoc.Arch = f.Arch
+		staged := stagedFile{uri: transactionFileURI(basedir, loc.Org, id, f.Arch, f.File), sha256: f.SHA256, size: f.Size}
+		err = commitRepoFile(ctx, basedir, archLoc, f.File, staged)
+		if err != nil {
+			// moving files around in the same place shouldn't fail, if it does there's no way of
+			// putting back what's already been replaced
+			log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Str("transaction", id).Str("arch", f.Arch).Str("file", f.File).Msg("failed to publish package from transaction, it's only partly committed")
+			break
+		}
+		observeUpload(loc.Org, loc.Distro, loc.Repo, f.Arch, f.Size)
+		published = append(published, f)
+	}
+	for _, unlock := range locks {
+		unlock()
+	}
+	if err != nil {
+		return t, published, fmt.Errorf("failed to publish %s: %w", id, err)
+	}
+
+	uploadSessionsLock.Lock()
+	err = afs.New().Delete(ctx, transactionURI(basedir, loc.Org, id))
+	uploadSessionsLock.Unlock()
+	if err != nil {
+		log.Warn().Err(err).Str("org", loc.Org).Str("transaction", id).Msg("failed to remove committed transaction")
+	}
+	var errs []error
+	for _, arch := range arches {
+		err := GenerateAPKIndex(basedir, loc.Org, loc.Distro, loc.Version, loc.Repo, arch)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to generate the index for %s: %w", arch, err))
+		}
+	}
+	return t, published, errors.Join(errs...)
+}
+
+func transactionFrom(t uploadTransaction) Transaction {
+	txn := Transaction{Id: t.ID, Expires: t.Expires, Files: []TransactionFile{}}
+	for _, f := range t.Files {
+		txn.Files = append(txn.Files, TransactionFile{Arch: f.Arch, File: f.File, Name: f.Name, Version: f.Version, Size: f.Size, Sha256: f.SHA256})
+	}
+	return txn
+}
+
+// transactionError - the response for an error loading or changing a transaction
+func transactionError(ctx echo.Context, loc RepoLocation, id string, err error) error {
+	switch {
+	case errors.Is(err, errTransactionNotFound):
+		return ctx.JSON(http.StatusNotFound, Error{Code: http.StatusNotFound, Message: err.Error()})
+	case errors.Is(err, errTransactionCommitting):
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	default:
+		log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Str("transaction", id).Msg("transaction failed")
+		return ctx.JSON(http.StatusInternalServerError, Error{Code: http.StatusInternalServerError, Message: "transaction failed: " + err.Error()})
+	}
+}
+
+// CreateTransaction - open an upload transaction for a repo
+func (p *PkgRepoAPI) CreateTransaction(ctx echo.Context, org, distro, version, repo string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	if !validPathSegments(org, distro, version, repo) {
+		return ctx.JSON(http.StatusBadRequest, Error{Code: http.StatusBadRequest, Message: "invalid repo"})
+	}
+	err := readOnlyRepoError(PackageBaseDirectory, loc)
+	if err != nil {
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	}
+	t, err := createTransaction(ctx.Request().Context(), PackageBaseDirectory, loc)
+	if err != nil {
+		return transactionError(ctx, loc, "", err)
+	}
+	return ctx.JSON(http.StatusCreated, transactionFrom(*t))
+}
+
+// GetTransaction - return a transaction and what's been uploaded into it
+func (p *PkgRepoAPI) GetTransaction(ctx echo.Context, org, distro, version, repo, id string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	t, err := loadTransaction(ctx.Request().Context(), PackageBaseDirectory, loc, id)
+	if err != nil {
+		return transactionError(ctx, loc, id, err)
+	}
+	return ctx.JSON(http.StatusOK, transactionFrom(*t))
+}
+
+// AbortTransaction - throw a transaction away without publishing anything
+func (p *PkgRepoAPI) AbortTransaction(ctx echo.Context, org, distro, version, repo, id string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	err := abortTransaction(ctx.Request().Context(), PackageBaseDirectory, loc, id)
+	if err != nil {
+		return transactionError(ctx, loc, id, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CommitTransaction - publish everything in a transaction
+func (p *PkgRepoAPI) CommitTransaction(ctx echo.Context, org, distro, version, repo, id string) error {
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo}
+	err := readOnlyRepoError(PackageBaseDirectory, loc)
+	if err != nil {
+		return ctx.JSON(http.StatusConflict, Error{Code: http.StatusConflict, Message: err.Error()})
+	}
+	t, published, err := commitTransaction(ctx.Request().Context(), PackageBaseDirectory, loc, id)
+	// only what made it into the repo, a commit that failed partway has published some of them
+	for _, f := range published {
+		auditFiles(ctx, AuditFile{Arch: optionalString(f.Arch), Name: f.File, Sha256: optionalString(f.SHA256), Size: &f.Size})
+	}
+	if err != nil {
+		return transactionError(ctx, loc, id, err)
+	}
+	return ctx.JSON(http.StatusOK, transactionFrom(*t))
+}
+
+// UploadTransactionFile - add a package to a transaction from the raw request body
+func (p *PkgRepoAPI) UploadTransactionFile(ctx echo.Context, org, distro, version, repo, id, arch, name string) error {
+	if !validPathSegments(org, distro, version, repo, arch) || !validUploadName(name) {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "bad_name", "packages have to be .apk files"})
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	req := ctx.Request()
+	// don't bother reading it if there's nowhere to put it
+	t, err := loadTransaction(req.Context(), PackageBaseDirectory, loc, id)
+	if err == nil && t.Committing {
+		err = errTransactionCommitting
+	}
+	if err != nil {
+		return transactionError(ctx, loc, id, err)
+	}
+	uerr := limitUpload(ctx)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	up, uerr := stageUpload(req.Context(), PackageBaseDirectory, name, req.Body)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	t, err = addTransactionFile(req.Context(), PackageBaseDirectory, loc, id, up)
+	if err != nil {
+		return transactionError(ctx, loc, id, err)
+	}
+	auditUpload(ctx, arch, up)
+	return ctx.JSON(http.StatusOK, transactionFrom(*t))
+}
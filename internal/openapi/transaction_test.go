@@ -0,0 +1,134 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-transaction-*")
+	if err != nil {
+		t.Fatal("failed to create testTransaction tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testTransaction tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+
+	_, err = createDistroKey(PackageBaseDirectory, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	const org, distro, version, repo = "testorg", "alpine", "edge", "main"
+	repoDir := filepath.Join(tmpDir, "static", org, distro, version, repo)
+	e := echo.New()
+	papi := &PkgRepoAPI{}
+	decode := func(rec *httptest.ResponseRecorder) Transaction {
+		var txn Transaction
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &txn))
+		return txn
+	}
+	open := func() Transaction {
+		rec := httptest.NewRecorder()
+		err := papi.CreateTransaction(e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec), org, distro, version, repo)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		return decode(rec)
+	}
+	upload := func(id, arch, name string, data []byte) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(data))
+		req.Header.Set(echo.HeaderContentType, "application/octet-stream")
+		err := papi.UploadTransactionFile(e.NewContext(req, rec), org, distro, version, repo, id, arch, name)
+		assert.NoError(t, err)
+		return rec
+	}
+	commit := func(id string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		err := papi.CommitTransaction(e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec), org, distro, version, repo, id)
+		assert.NoError(t, err)
+		return rec
+	}
+
+	txn := open()
+	assert.Empty(t, txn.Files)
+	for _, name := range []string{"foo", "foo-dev"} {
+		rec := upload(txn.Id, "x86_64", name+"-1.0-r0.apk", buildTestApk(t, name, "1.0-r0", "x86_64"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+	rec := upload(txn.Id, "aarch64", "foo-1.0-r0.apk", buildTestApk(t, "foo", "1.0-r0", "aarch64"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = upload(txn.Id, "x86_64", "broken-1.0-r0.apk", []byte("not a package"))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	txn = decode(upload(txn.Id, "x86_64", "foo-1.0-r0.apk", buildTestApk(t, "foo", "1.0-r0", "x86_64")))
+	if assert.Len(t, txn.Files, 3) {
+		assert.Equal(t, "aarch64", txn.Files[0].Arch)
+		assert.Equal(t, "foo-dev-1.0-r0.apk", txn.Files[2].File)
+		assert.Equal(t, "foo-dev", txn.Files[2].Name)
+	}
+	// nothing's visible yet
+	assert.NoDirExists(t, filepath.Join(repoDir, "x86_64"))
+
+	rec = commit(txn.Id)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.FileExists(t, filepath.Join(repoDir, "x86_64", "foo-1.0-r0.apk"))
+	assert.FileExists(t, filepath.Join(repoDir, "x86_64", "foo-dev-1.0-r0.apk"))
+	assert.FileExists(t, filepath.Join(repoDir, "aarch64", "foo-1.0-r0.apk"))
+	pkgs, err := loadRepoIndex(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: "x86_64"})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 2)
+	pkgs, err = loadRepoIndex(PackageBaseDirectory, RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: "aarch64"})
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 1)
+	rec = commit(txn.Id)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// a commit that stops partway only audits what it published
+	txn = open()
+	for _, arch := range []string{"aarch64", "riscv64"} {
+		rec = upload(txn.Id, arch, "baz-1.0-r0.apk", buildTestApk(t, "baz", "1.0-r0", arch))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+	// riscv64 can't be created
+	assert.NoError(t, os.WriteFile(filepath.Join(repoDir, "riscv64"), nil, 0644))
+	rec = httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+	assert.NoError(t, papi.CommitTransaction(c, org, distro, version, repo, txn.Id))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.FileExists(t, filepath.Join(repoDir, "aarch64", "baz-1.0-r0.apk"))
+	files, _ := c.Get(auditFilesKey).([]AuditFile)
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, "aarch64", *files[0].Arch)
+		assert.Equal(t, "baz-1.0-r0.apk", files[0].Name)
+	}
+	assert.NoError(t, os.Remove(filepath.Join(repoDir, "riscv64")))
+
+	// an aborted transaction leaves nothing behind
+	txn = open()
+	rec = upload(txn.Id, "x86_64", "bar-1.0-r0.apk", buildTestApk(t, "bar", "1.0-r0", "x86_64"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = httptest.NewRecorder()
+	err = papi.AbortTransaction(e.NewContext(httptest.NewRequest(http.MethodDelete, "/", nil), rec), org, distro, version, repo, txn.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = upload(txn.Id, "x86_64", "bar-1.0-r0.apk", buildTestApk(t, "bar", "1.0-r0", "x86_64"))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoFileExists(t, filepath.Join(repoDir, "x86_64", "bar-1.0-r0.apk"))
+	assert.NoDirExists(t, filepath.Join(tmpDir, uploadsDir, org, txn.Id))
+	entries, err := os.ReadDir(filepath.Join(tmpDir, uploadsDir))
+	assert.NoError(t, err)
+	// just the org dir, no staging files
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+// uploads are never held in memory or a temp file first: the body is read once, and on the way
+// to a staging file in uploads/ it's hashed and parsed, so by the time it's all arrived we know
+// whether it's a package and what it is. it's only moved into the repo after that, and a client
+// that goes away, a body that's too big or something that isn't a package just leaves the staging
+// file to be removed
+
+// MaxUploadSize - the biggest request body a package upload can have, in bytes
+var MaxUploadSize int64 = 1 << 30
+
+// SetMaxUploadSize - set the biggest request body a package upload can have, in bytes
+func SetMaxUploadSize(size int64) {
+	if size < 1 {
+		size = 1
+	}
+	MaxUploadSize = size
+	log.Info().Int64("bytes", MaxUploadSize).Msg("set max upload size")
+}
+
+// errInvalidPackage - the upload was read fine, it just isn't a package
+type errInvalidPackage struct {
+	err error
+}
+
+func (e errInvalidPackage) Error() string { return e.err.Error() }
+func (e errInvalidPackage) Unwrap() error { return e.err }
+
+// receivedUpload - a package that's been staged, and what it is
+type receivedUpload struct {
+	name   string
+	staged stagedFile
+	pkg    *repository.Package
+}
+
+// IsPackageUpload - whether a request uploads a package, the request validator mustn't read their
+// bodies or they'd end up in memory
+func IsPackageUpload(ctx echo.Context) bool {
+	switch ctx.Request().Method {
+	case http.MethodPost:
+		switch ctx.Path() {
+		case "/:org/:distro/:version/:repo/:arch/pkgs", "/:org/:distro/:version/:repo/pkgs":
+			return true
+		}
+	case http.MethodPut:
+		switch ctx.Path() {
+		case "/:org/:distro/:version/:repo/:arch/:file", "/:org/:distro/:version/:repo/:arch/uploads/:id", "/:org/:distro/:version/:repo/transactions/:id/:arch/:file":
+			return true
+		}
+	}
+	return false
+}
+
+// validUploadName - whether name is something we'd write into a repo
+func validUploadName(name string) bool {
+	return validPathSegments(name) && strings.HasSuffix(name, ".apk")
+}
+
+// limitUpload - cap the request body at MaxUploadSize, refusing straight away if the client already
+// said it's bigger
+func limitUpload(ctx echo.Context) *uploadError {
+	req := ctx.Request()
+	if req.ContentLength > MaxUploadSize {
+		return &uploadError{http.StatusRequestEntityTooLarge, "too_large", "upload is bigger than the server allows"}
+	}
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, MaxUploadSize)
+	return nil
+}
+
+// stageUpload - stream r to a staging file, parsing it as a package on the way
+func stageUpload(ctx context.Context, basedir, name string, r io.Reader) (receivedUpload, *uploadError) {
+	up := receivedUpload{name: name}
+	var err error
+	up.staged, err = stageFile(ctx, afs.New(), basedir, r, func(r io.Reader) error {
+		pkg, err := repository.ParsePackage(r)
+		if err != nil {
+			return errInvalidPackage{err}
+		}
+		up.pkg = pkg
+		return nil
+	})
+	if err == nil {
+		return up, nil
+	}
+
+	var tooLarge *http.MaxBytesError
+	var staging errStaging
+	var invalid errInvalidPackage
+	switch {
+	case errors.As(err, &tooLarge):
+		return up, &uploadError{http.StatusRequestEntityTooLarge, "too_large", "upload is bigger than the server allows"}
+	case errors.As(err, &staging):
+		log.Error().Err(err).Str("file", name).Msg("failed to write staging file for upload")
+		return up, &uploadError{http.StatusInternalServerError, "write_failed", "failed to store package"}
+	case errors.As(err, &invalid):
+		log.Warn().Err(err).Str("file", name).Msg("failed to parse package from uploaded file")
+		return up, &uploadError{http.StatusBadRequest, "invalid_package", "failed to parse upload"}
+	default:
+		log.Warn().Err(err).Str("file", name).Int64("bytes", up.staged.size).Msg("failed to read upload")
+		return up, &uploadError{http.StatusBadRequest, "unreadable", "failed to read upload"}
+	}
+}
+
+// receiveMultipartUpload - stage the package part of a multipart upload, without buffering the rest
+// of the form either
+func receiveMultipartUpload(ctx echo.Context) (receivedUpload, *uploadError) {
+	uerr := limitUpload(ctx)
+	if uerr != nil {
+		return receivedUpload{}, uerr
+	}
+	req := ctx.Request()
+	mr, err := req.MultipartReader()
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to get file from submitted data")
+		return receivedUpload{}, &uploadError{http.StatusBadRequest, "missing_file", "no package in upload"}
+	}
+	for {
+		part, err := mr.NextPart()
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.Is(err, io.EOF):
+			return receivedUpload{}, &uploadError{http.StatusBadRequest, "missing_file", "no package in upload"}
+		case errors.As(err, &tooLarge):
+			return receivedUpload{}, &uploadError{http.StatusRequestEntityTooLarge, "too_large", "upload is bigger than the server allows"}
+		case err != nil:
+			log.Warn().Err(err).Msg("failed to read upload")
+			return receivedUpload{}, &uploadError{http.StatusBadRequest, "unreadable", "failed to read upload"}
+		}
+		if part.FormName() != "package" {
+			continue
+		}
+		name := part.FileName()
+		if !validUploadName(name) {
+			return receivedUpload{}, &uploadError{http.StatusBadRequest, "bad_name", "packages have to be .apk files"}
+		}
+		return stageUpload(req.Context(), PackageBaseDirectory, name, part)
+	}
+}
+
+// storeUpload - move a staged upload into a repo location and count it
+func storeUpload(ctx context.Context, loc RepoLocation, up receivedUpload) *uploadError {
+	err := commitRepoFile(ctx, PackageBaseDirectory, loc, up.name, up.staged)
+	if err != nil {
+		log.Error().Err(err).Str("org", loc.Org).Str("repo", loc.Repo).Str("arch", loc.Arch).Str("file", up.name).Msg("failed to store uploaded package")
+		return &uploadError{http.StatusInternalServerError, "write_failed", "failed to store package"}
+	}
+	observeUpload(loc.Org, loc.Distro, loc.Repo, loc.Arch, up.staged.size)
+	return nil
+}
+
+// UploadRepoFile - add a package to a repo from the raw request body, replacing it if it's
+// already there
+func (p *PkgRepoAPI) UploadRepoFile(ctx echo.Context, org, distro, version, repo, arch, name string) error {
+	if !validPathSegments(org, distro, version, repo, arch) || !validUploadName(name) {
+		return rejectUpload(ctx, org, uploadError{http.StatusBadRequest, "bad_name", "packages have to be .apk files"})
+	}
+	loc := RepoLocation{Org: org, Distro: distro, Version: version, Repo: repo, Arch: arch}
+	err := readOnlyRepoError(PackageBaseDirectory, loc)
+	if err != nil {
+		return rejectUpload(ctx, org, uploadError{http.StatusConflict, "read_only_repo", err.Error()})
+	}
+	uerr := limitUpload(ctx)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	req := ctx.Request()
+	up, uerr := stageUpload(req.Context(), PackageBaseDirectory, name, req.Body)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	uerr = storeUpload(req.Context(), loc, up)
+	if uerr != nil {
+		return rejectUpload(ctx, org, *uerr)
+	}
+	auditUpload(ctx, arch, up)
+	return ctx.JSON(http.StatusOK, &Package{Name: up.pkg.Name, Version: &up.pkg.Version})
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// brokenReader - a client that goes away after sending some of the body
+type brokenReader struct {
+	r io.Reader
+}
+
+func (b *brokenReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset by peer")
+	}
+	return n, err
+}
+
+func TestUploadRepoFile(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-upload-*")
+	if err != nil {
+		t.Fatal("failed to create testUploadRepoFile tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testUploadRepoFile tmpDir", err)
+		}
+	}()
+	originalDir := PackageBaseDirectory
+	PackageBaseDirectory = "file://" + tmpDir
+	defer func() { PackageBaseDirectory = originalDir }()
+	originalMax := MaxUploadSize
+	defer func() { MaxUploadSize = originalMax }()
+
+	e := echo.New()
+	papi := &PkgRepoAPI{}
+	foo := buildTestApk(t, "foo", "1.0-r0", "x86_64")
+	upload := func(name string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/", body)
+		req.Header.Set(echo.HeaderContentType, "application/octet-stream")
+		err := papi.UploadRepoFile(e.NewContext(req, rec), "testorg", "alpine", "edge", "main", "x86_64", name)
+		assert.NoError(t, err)
+		return rec
+	}
+	stagingLeft := func() []os.DirEntry {
+		entries, _ := os.ReadDir(filepath.Join(tmpDir, uploadsDir))
+		return entries
+	}
+	pkgFile := filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main", "x86_64", "foo-1.0-r0.apk")
+
+	rec := upload("foo-1.0-r0.apk", bytes.NewReader(foo))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var result Package
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, "foo", result.Name)
+	assert.Equal(t, "1.0-r0", *result.Version)
+	data, err := os.ReadFile(pkgFile)
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	assert.Empty(t, stagingLeft())
+
+	// none of these touch what's already there, or leave anything behind
+	rec = upload("../foo-1.0-r0.apk", bytes.NewReader(foo))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = upload("foo-1.0-r0.tar", bytes.NewReader(foo))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = upload("foo-1.0-r0.apk", bytes.NewReader([]byte("not a package")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = upload("foo-1.0-r0.apk", &brokenReader{bytes.NewReader(foo[:len(foo)/2])})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	MaxUploadSize = int64(len(foo) - 1)
+	rec = upload("foo-1.0-r0.apk", bytes.NewReader(foo))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	// without a content length it's only noticed part way through
+	rec = upload("foo-1.0-r0.apk", io.MultiReader(bytes.NewReader(foo)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	MaxUploadSize = originalMax
+	data, err = os.ReadFile(pkgFile)
+	assert.NoError(t, err)
+	assert.Equal(t, foo, data)
+	assert.Empty(t, stagingLeft())
+
+	// multipart uploads are streamed the same way
+	rec = httptest.NewRecorder()
+	bar := buildTestApk(t, "bar", "1.0-r0", "x86_64")
+	err = papi.CreatePackage(e.NewContext(uploadRequest(t, "bar-1.0-r0.apk", bar), rec), "testorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	data, err = os.ReadFile(filepath.Join(filepath.Dir(pkgFile), "bar-1.0-r0.apk"))
+	assert.NoError(t, err)
+	assert.Equal(t, bar, data)
+	assert.Empty(t, stagingLeft())
+	// and turned away from the same paths
+	rec = httptest.NewRecorder()
+	err = papi.CreatePackage(e.NewContext(uploadRequest(t, "bar-1.0-r0.apk", bar), rec), "testorg", "alpine", "edge", "main", "..")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.NoFileExists(t, filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "bar-1.0-r0.apk"))
+}
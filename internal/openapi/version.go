@@ -0,0 +1,220 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// apk version strings look like 1.2.3a_rc1_p2-r0
+// digits separated by dots, an optional letter, any number of suffixes and the package release
+
+// suffix ordering as apk-tools sees it, pre-release suffixes sort below a bare version and
+// post-release suffixes sort above it
+var versionSuffixes = map[string]int{
+	"alpha": -4,
+	"beta":  -3,
+	"pre":   -2,
+	"rc":    -1,
+	"cvs":   1,
+	"svn":   2,
+	"git":   3,
+	"hg":    4,
+	"p":     5,
+}
+
+type versionSuffix struct {
+	kind int
+	num  uint64
+}
+
+type parsedVersion struct {
+	nums     []uint64
+	letter   byte
+	suffixes []versionSuffix
+	release  uint64
+}
+
+func parseVersion(v string) (parsedVersion, error) {
+	var pv parsedVersion
+	if v == "" {
+		return pv, fmt.Errorf("empty version")
+	}
+
+	if i := strings.LastIndex(v, "-r"); i >= 0 {
+		rel, err := strconv.ParseUint(v[i+2:], 10, 64)
+		if err != nil {
+			return pv, fmt.Errorf("invalid release in version %q", v)
+		}
+		pv.release = rel
+		v = v[:i]
+	}
+
+	// strip any commit hash suffix (~abcdef), it doesn't take part in ordering
+	if i := strings.Index(v, "~"); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, "_")
+	base := parts[0]
+	if base == "" {
+		return pv, fmt.Errorf("invalid version %q", v)
+	}
+	if c := base[len(base)-1]; c >= 'a' && c <= 'z' {
+		pv.letter = c
+		base = base[:len(base)-1]
+	}
+	for _, n := range strings.Split(base, ".") {
+		num, err := strconv.ParseUint(n, 10, 64)
+		if err != nil {
+			return pv, fmt.Errorf("invalid version %q", v)
+		}
+		pv.nums = append(pv.nums, num)
+	}
+
+	for _, s := range parts[1:] {
+		name := strings.TrimRight(s, "0123456789")
+		kind, ok := versionSuffixes[name]
+		if !ok {
+			return pv, fmt.Errorf("invalid suffix %q in version %q", s, v)
+		}
+		var num uint64
+		if len(name) < len(s) {
+			num, _ = strconv.ParseUint(s[len(name):], 10, 64)
+		}
+		pv.suffixes = append(pv.suffixes, versionSuffix{kind: kind, num: num})
+	}
+
+	return pv, nil
+}
+
+func cmpUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareVersions - compare two apk versions, returns -1, 0 or 1 like strings.Compare
+// versions that fail to parse fall back to a plain string comparison
+func compareVersions(a, b string) int {
+	va, erra := parseVersion(a)
+	vb, errb := parseVersion(b)
+	if erra != nil || errb != nil {
+		return strings.Compare(a, b)
+	}
+
+	for i := 0; i < len(va.nums) || i < len(vb.nums); i++ {
+		if i >= len(va.nums) {
+			return -1
+		}
+		if i >= len(vb.nums) {
+			return 1
+		}
+		if c := cmpUint(va.nums[i], vb.nums[i]); c != 0 {
+			return c
+		}
+	}
+
+	if va.letter != vb.letter {
+		if va.letter < vb.letter {
+			return -1
+		}
+		return 1
+	}
+
+	for i := 0; i < len(va.suffixes) || i < len(vb.suffixes); i++ {
+		var sa, sb versionSuffix
+		if i < len(va.suffixes) {
+			sa = va.suffixes[i]
+		}
+		if i < len(vb.suffixes) {
+			sb = vb.suffixes[i]
+		}
+		if sa.kind != sb.kind {
+			if sa.kind < sb.kind {
+				return -1
+			}
+			return 1
+		}
+		if c := cmpUint(sa.num, sb.num); c != 0 {
+			return c
+		}
+	}
+
+	return cmpUint(va.release, vb.release)
+}
+
+// versionConstraint - a single comparison like >=1.2.3 or ~1.2
+type versionConstraint struct {
+	op      string
+	version string
+}
+
+// operators ordered so the longer ones are tried first
+var constraintOps = []string{">=", "<=", "!=", "><", ">", "<", "=", "~"}
+
+func parseVersionConstraint(s string) (versionConstraint, error) {
+	for _, op := range constraintOps {
+		if strings.HasPrefix(s, op) {
+			v := strings.TrimSpace(s[len(op):])
+			if _, err := parseVersion(v); err != nil {
+				return versionConstraint{}, err
+			}
+			return versionConstraint{op: op, version: v}, nil
+		}
+	}
+	// a bare version means an exact match
+	if _, err := parseVersion(s); err != nil {
+		return versionConstraint{}, err
+	}
+	return versionConstraint{op: "=", version: s}, nil
+}
+
+// parseVersionRange - parse a list of constraints separated by spaces or commas, all of which
+// have to match (e.g. ">=1.2 <2")
+func parseVersionRange(s string) ([]versionConstraint, error) {
+	var result []versionConstraint
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ',' }) {
+		c, err := parseVersionConstraint(f)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func (c versionConstraint) matches(v string) bool {
+	switch c.op {
+	case "=":
+		return compareVersions(v, c.version) == 0
+	case "!=", "><":
+		return compareVersions(v, c.version) != 0
+	case ">":
+		return compareVersions(v, c.version) > 0
+	case ">=":
+		return compareVersions(v, c.version) >= 0
+	case "<":
+		return compareVersions(v, c.version) < 0
+	case "<=":
+		return compareVersions(v, c.version) <= 0
+	case "~":
+		// fuzzy match, ~1.2 matches 1.2, 1.2.3 and 1.2-r4 but not 1.20
+		return v == c.version || strings.HasPrefix(v, c.version+".") ||
+			strings.HasPrefix(v, c.version+"-") || strings.HasPrefix(v, c.version+"_")
+	}
+	return false
+}
+
+func versionInRange(v string, constraints []versionConstraint) bool {
+	for _, c := range constraints {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.1", -1},
+		{"1.10", "1.9", 1},
+		{"1.0", "1.0.1", -1},
+		{"1.0a", "1.0", 1},
+		{"1.0_rc1", "1.0", -1},
+		{"1.0_alpha2", "1.0_beta1", -1},
+		{"1.0_p1", "1.0", 1},
+		{"1.0-r1", "1.0-r0", 1},
+		{"1.0-r10", "1.0-r9", 1},
+		{"2.0-r0", "1.99-r5", 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.want, compareVersions(tc.a, tc.b))
+			assert.Equal(t, -tc.want, compareVersions(tc.b, tc.a))
+		})
+	}
+}
+
+func TestVersionInRange(t *testing.T) {
+	constraints, err := parseVersionRange(">=1.2 <2")
+	assert.NoError(t, err)
+	assert.True(t, versionInRange("1.2-r0", constraints))
+	assert.True(t, versionInRange("1.10.3-r1", constraints))
+	assert.False(t, versionInRange("1.1.9-r0", constraints))
+	assert.False(t, versionInRange("2.0-r0", constraints))
+
+	constraints, err = parseVersionRange("~1.2")
+	assert.NoError(t, err)
+	assert.True(t, versionInRange("1.2.3-r0", constraints))
+	assert.False(t, versionInRange("1.20-r0", constraints))
+
+	_, err = parseVersionRange(">=not.a.version")
+	assert.Error(t, err)
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+	"gopkg.in/yaml.v3"
+)
+
+// a virtual repo merges other repos in the same org/distro/version into one index, it's set up
+// with config/<org>/<distro>/<version>/<repo>/virtual.yaml:
+//
+//	members: [extras, main, community]
+//	precedence: order
+//
+// when more than one member has a package, precedence decides which one ends up in the index:
+// order (the default) takes it from the first member that has it, version takes the highest
+// version (the first member wins a tie)
+// the index is signed with the distro's keys like any other, and is regenerated whenever a
+// member's index is. nothing is copied, packages are served from whichever member they came from
+// by GetRepoFile
+
+const (
+	virtualConfigFile = "virtual.yaml"
+
+	precedenceOrder   = "order"
+	precedenceVersion = "version"
+)
+
+var errVirtualRepo = errors.New("repo is a virtual repo, its packages come from its members")
+
+// virtualConfig - the contents of virtual.yaml
+type virtualConfig struct {
+	Members    []string `yaml:"members"`
+	Precedence string   `yaml:"precedence,omitempty"`
+}
+
+// loadVirtualConfig - read a repo's virtual.yaml, repos that aren't virtual return nil
+func loadVirtualConfig(basedir string, loc RepoLocation) (*virtualConfig, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	uri := url.JoinUNC(mirrorConfigURI(basedir, loc), virtualConfigFile)
+	ex, err := cfs.Exists(ctx, uri)
+	if err != nil || !ex {
+		return nil, err
+	}
+	data, err := cfs.DownloadWithURL(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	var cfg virtualConfig
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+	if len(cfg.Members) == 0 {
+		return nil, fmt.Errorf("%s doesn't have any members", uri)
+	}
+	if slices.Contains(cfg.Members, loc.Repo) {
+		return nil, fmt.Errorf("%s has itself as a member", uri)
+	}
+	switch cfg.Precedence {
+	case "":
+		cfg.Precedence = precedenceOrder
+	case precedenceOrder, precedenceVersion:
+	default:
+		return nil, fmt.Errorf("%s has an unknown precedence %q", uri, cfg.Precedence)
+	}
+	return &cfg, nil
+}
+
+// memberLocation - where a member of a virtual repo is
+func (v virtualConfig) memberLocation(loc RepoLocation, member string) RepoLocation {
+	loc.Repo = member
+	return loc
+}
+
+// mergeIndexes - merge the members' packages, in member order, keeping one member's copy of
+// each package name according to precedence
+func mergeIndexes(members [][]*repository.Package, precedence string) []*repository.Package {
+	type choice struct {
+		member int
+		pkg    *repository.Package
+	}
+	chosen := map[string]choice{}
+	for i, pkgs := range members {
+		for _, p := range pkgs {
+			c, ok := chosen[p.Name]
+			switch {
+			case !ok:
+				chosen[p.Name] = choice{member: i, pkg: p}
+			case precedence == precedenceVersion && compareVersions(p.Version, c.pkg.Version) > 0:
+				chosen[p.Name] = choice{member: i, pkg: p}
+			}
+		}
+	}
+	var merged []*repository.Package
+	for i, pkgs := range members {
+		for _, p := range pkgs {
+			c := chosen[p.Name]
+			if c.member != i {
+				continue
+			}
+			// an index can have more than one version of a package, with order precedence the
+			// winning member's are all kept
+			if precedence == precedenceVersion && c.pkg != p {
+				continue
+			}
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
+
+// generateVirtualIndex - merge the members' indexes for an arch into the virtual repo's index
+func generateVirtualIndex(basedir string, loc RepoLocation, cfg virtualConfig, signers []Signer) error {
+	log.Info().Str("org", loc.Org).Str("distro", loc.Distro).Str("version", loc.Version).Str("repo", loc.Repo).Str("arch", loc.Arch).Strs("members", cfg.Members).Msg("starting virtual APK index generation")
+	var members [][]*repository.Package
+	for _, m := range cfg.Members {
+		mloc := cfg.memberLocation(loc, m)
+		nested, err := loadVirtualConfig(basedir, mloc)
+		if err != nil {
+			return err
+		}
+		if nested != nil {
+			return fmt.Errorf("member %s is a virtual repo, they can't be nested", m)
+		}
+		pkgs, err := loadRepoIndex(basedir, mloc)
+		if err != nil {
+			log.Error().Err(err).Str("member", m).Msg("generateVirtualIndex: failed to load member index")
+			return fmt.Errorf("failed to load the index for member %s: %w", m, err)
+		}
+		if pkgs == nil {
+			log.Warn().Str("member", m).Str("arch", loc.Arch).Msg("generateVirtualIndex: member doesn't have an index")
+		}
+		members = append(members, pkgs)
+	}
+
+	apki := repository.ApkIndex{
+		Description: fmt.Sprintf("%s %s %s", loc.Org, loc.Repo, loc.Version),
+		Packages:    mergeIndexes(members, cfg.Precedence),
+	}
+	err := writeSignedIndex(basedir, loc, &apki, signers)
+	if err != nil {
+		return err
+	}
+	indexPackages.WithLabelValues(loc.Org, loc.Distro, loc.Version, loc.Repo, loc.Arch).Set(float64(len(apki.Packages)))
+	log.Info().Str("org", loc.Org).Str("distro", loc.Distro).Str("version", loc.Version).Str("repo", loc.Repo).Str("arch", loc.Arch).Int("packages", len(apki.Packages)).Msg("finished generating virtual apk index")
+	return nil
+}
+
+// virtualReposWith - the virtual repos in the same org/distro/version that have member in them
+func virtualReposWith(basedir string, member RepoLocation) ([]RepoLocation, error) {
+	ctx := context.Background()
+	cfs := afs.New()
+	versionURI := url.JoinUNC(basedir, "config", member.Org, member.Distro, member.Version)
+	ex, err := cfs.Exists(ctx, versionURI)
+	if err != nil || !ex {
+		return nil, err
+	}
+	list, err := cfs.List(ctx, url.Normalize(versionURI, file.Scheme))
+	if err != nil {
+		return nil, err
+	}
+	var virtuals []RepoLocation
+	for _, f := range list {
+		if !f.IsDir() || f.URL() == url.Normalize(versionURI, file.Scheme) {
+			continue
+		}
+		loc := RepoLocation{Org: member.Org, Distro: member.Distro, Version: member.Version, Repo: f.Name(), Arch: member.Arch}
+		cfg, err := loadVirtualConfig(basedir, loc)
+		if err != nil {
+			log.Error().Err(err).Str("repo", f.Name()).Msg("failed to load virtual repo config")
+			continue
+		}
+		if cfg != nil && slices.Contains(cfg.Members, member.Repo) {
+			virtuals = append(virtuals, loc)
+		}
+	}
+	return virtuals, nil
+}
+
+// regenerateVirtualRepos - regenerate the indexes of the virtual repos a repo is a member of,
+// after its index changed. errors are only logged, the member's index is fine either way
+func regenerateVirtualRepos(basedir string, member RepoLocation, signers []Signer) {
+	virtuals, err := virtualReposWith(basedir, member)
+	if err != nil {
+		log.Error().Err(err).Str("repo", member.Repo).Msg("failed to look for virtual repos")
+		return
+	}
+	for _, v := range virtuals {
+		// failures are logged by GenerateAPKIndexWithSigners
+		_ = GenerateAPKIndexWithSigners(basedir, v.Org, v.Distro, v.Version, v.Repo, v.Arch, signers...)
+	}
+}
+
+// virtualMember - the member a package in a virtual repo's index comes from, the first member
+// with the same file and checksum is the one whose copy was merged
+func virtualMember(basedir string, loc RepoLocation, cfg virtualConfig, name string) (RepoLocation, error) {
+	merged, err := loadRepoIndex(basedir, loc)
+	if err != nil {
+		return RepoLocation{}, err
+	}
+	var want *repository.Package
+	for _, p := range merged {
+		if p.Filename() == name {
+			want = p
+			break
+		}
+	}
+	if want == nil {
+		return RepoLocation{}, errNoRepoFile
+	}
+	for _, m := range cfg.Members {
+		mloc := cfg.memberLocation(loc, m)
+		pkgs, err := loadRepoIndex(basedir, mloc)
+		if err != nil {
+			return RepoLocation{}, err
+		}
+		for _, p := range pkgs {
+			if p.Filename() == name && slices.Equal(p.Checksum, want.Checksum) {
+				return mloc, nil
+			}
+		}
+	}
+	// the member changed since the virtual index was generated
+	return RepoLocation{}, errNoRepoFile
+}
+
+// virtualRepoFile - repoFile for a virtual repo. proxy members' indexes are refreshed first (and
+// the virtual index regenerated if they were), and packages come from the member they're from
+func virtualRepoFile(ctx context.Context, basedir string, loc RepoLocation, cfg virtualConfig, name string) (string, error) {
+	if name != "APKINDEX.tar.gz" {
+		if !strings.HasSuffix(name, ".apk") {
+			return "", errNoRepoFile
+		}
+		member, err := virtualMember(basedir, loc, cfg, name)
+		if err != nil {
+			return "", err
+		}
+		return repoFile(ctx, basedir, member, name)
+	}
+
+	for _, m := range cfg.Members {
+		mloc := cfg.memberLocation(loc, m)
+		proxy, err := loadProxyConfig(basedir, mloc)
+		if err != nil {
+			return "", err
+		}
+		if proxy == nil {
+			continue
+		}
+		refreshed, err := refreshProxyIndex(ctx, basedir, mloc, *proxy)
+		if err != nil {
+			return "", err
+		}
+		if refreshed {
+			regenerateVirtualRepos(basedir, mloc, nil)
+		}
+	}
+	indexURI := url.JoinUNC(loc.staticURI(basedir), "APKINDEX.tar.gz")
+	ex, err := afs.New().Exists(ctx, indexURI)
+	if err != nil {
+		return "", err
+	}
+	if !ex {
+		// this is a public GET, it doesn't get to sign and write things. the index appears when a
+		// member's is generated, or when it's regenerated with POST .../index
+		return "", errNoRepoFile
+	}
+	return indexURI, nil
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.alpinelinux.org/alpine/go/repository"
+)
+
+func TestMergeIndexes(t *testing.T) {
+	extras := []*repository.Package{{Name: "foo", Version: "1.0-r0"}, {Name: "ours", Version: "1.0-r0"}}
+	main := []*repository.Package{{Name: "foo", Version: "1.2-r0"}, {Name: "bar", Version: "1.0-r0"}}
+	community := []*repository.Package{{Name: "bar", Version: "0.9-r0"}, {Name: "baz", Version: "1.0-r0"}}
+	members := [][]*repository.Package{extras, main, community}
+
+	names := func(pkgs []*repository.Package) []string {
+		var n []string
+		for _, p := range pkgs {
+			n = append(n, p.Name+"-"+p.Version)
+		}
+		return n
+	}
+	assert.Equal(t, []string{"foo-1.0-r0", "ours-1.0-r0", "bar-1.0-r0", "baz-1.0-r0"}, names(mergeIndexes(members, precedenceOrder)))
+	assert.Equal(t, []string{"ours-1.0-r0", "foo-1.2-r0", "bar-1.0-r0", "baz-1.0-r0"}, names(mergeIndexes(members, precedenceVersion)))
+}
+
+func TestVirtualRepo(t *testing.T) {
+	// Setup test directory structure
+	tmpDir, err := os.MkdirTemp("", "test-virtual-*")
+	if err != nil {
+		t.Fatal("failed to create testVirtualRepo tmpDir", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			t.Fatal("failed to remove testVirtualRepo tmpDir", err)
+		}
+	}()
+	basedir := "file://" + tmpDir
+
+	_, err = createDistroKey(basedir, "testorg", "alpine", minKeyBits, false)
+	assert.NoError(t, err)
+	writeApk := func(repo, name, version string) {
+		dir := filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", repo, "x86_64")
+		err := os.MkdirAll(dir, 0755)
+		if err != nil {
+			t.Fatal("failed to create test repo dir", err)
+		}
+		err = os.WriteFile(filepath.Join(dir, name+"-"+version+".apk"), buildTestApk(t, name, version, "x86_64"), 0644)
+		if err != nil {
+			t.Fatal("failed to write test package", err)
+		}
+	}
+	writeApk("main", "foo", "1.0-r0")
+	writeApk("main", "bar", "1.0-r0")
+	writeApk("extras", "foo", "1.0-r0")
+	writeApk("extras", "ours", "2.0-r0")
+
+	configDir := filepath.Join(tmpDir, "config", "testorg", "alpine", "edge", "all")
+	err = os.MkdirAll(configDir, 0755)
+	if err != nil {
+		t.Fatal("failed to create test virtual config dir", err)
+	}
+	err = os.WriteFile(filepath.Join(configDir, virtualConfigFile), []byte("members: [extras, main]\n"), 0644)
+	if err != nil {
+		t.Fatal("failed to write test virtual config", err)
+	}
+	loc := RepoLocation{Org: "testorg", Distro: "alpine", Version: "edge", Repo: "all", Arch: "x86_64"}
+	assert.True(t, errors.Is(readOnlyRepoError(basedir, loc), errVirtualRepo))
+
+	// generating a member's index regenerates the virtual repo's
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "extras", "x86_64")
+	assert.NoError(t, err)
+	pkgs, err := loadRepoIndex(basedir, loc)
+	assert.NoError(t, err)
+	assert.Len(t, pkgs, 2)
+
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "main", "x86_64")
+	assert.NoError(t, err)
+	pkgs, err = loadRepoIndex(basedir, loc)
+	assert.NoError(t, err)
+	var names []string
+	for _, p := range pkgs {
+		names = append(names, p.Filename())
+	}
+	assert.ElementsMatch(t, []string{"foo-1.0-r0.apk", "ours-2.0-r0.apk", "bar-1.0-r0.apk"}, names)
+
+	// packages are served from the member that won, without being copied
+	uri, err := repoFile(context.Background(), basedir, loc, "foo-1.0-r0.apk")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "extras", "x86_64", "foo-1.0-r0.apk"), uri)
+	uri, err = repoFile(context.Background(), basedir, loc, "bar-1.0-r0.apk")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "main", "x86_64", "bar-1.0-r0.apk"), uri)
+	_, err = repoFile(context.Background(), basedir, loc, "nope-1.0-r0.apk")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "all", "x86_64", "foo-1.0-r0.apk"))
+
+	// fetching the index of a virtual repo that hasn't been generated yet doesn't generate it
+	otherDir := filepath.Join(tmpDir, "config", "testorg", "alpine", "edge", "other")
+	assert.NoError(t, os.MkdirAll(otherDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(otherDir, virtualConfigFile), []byte("members: [main]\n"), 0644))
+	other := loc
+	other.Repo = "other"
+	_, err = repoFile(context.Background(), basedir, other, "APKINDEX.tar.gz")
+	assert.True(t, errors.Is(err, errNoRepoFile))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "other", "x86_64", "APKINDEX.tar.gz"))
+	err = GenerateAPKIndex(basedir, "testorg", "alpine", "edge", "other", "x86_64")
+	assert.NoError(t, err)
+	uri, err = repoFile(context.Background(), basedir, other, "APKINDEX.tar.gz")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(tmpDir, "static", "testorg", "alpine", "edge", "other", "x86_64", "APKINDEX.tar.gz"), uri)
+}